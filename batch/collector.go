@@ -0,0 +1,81 @@
+package batch
+
+import (
+	"sync"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// Collector gathers the results of a known number of concurrent tasks.
+type Collector[T any] struct {
+	mu     sync.Mutex
+	n      int
+	added  int
+	values []T
+	errs   []error
+	done   chan struct{}
+	failed chan struct{}
+}
+
+// NewCollector creates a collector expecting `n` results.
+func NewCollector[T any](n int) *Collector[T] {
+	c := &Collector[T]{
+		n:      n,
+		values: make([]T, 0, n),
+		done:   make(chan struct{}),
+		failed: make(chan struct{}),
+	}
+	if n <= 0 {
+		close(c.done)
+	}
+	return c
+}
+
+// Add records a result, it is safe to call from multiple goroutines.
+// Results added beyond the expected count are ignored.
+func (c *Collector[T]) Add(r *result.Result[T]) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.added >= c.n {
+		return
+	}
+	c.added++
+	if r.IsErr() {
+		if len(c.errs) == 0 {
+			close(c.failed)
+		}
+		c.errs = append(c.errs, r.UnwrapError())
+	} else if v := r.Unwrap(); v != nil {
+		c.values = append(c.values, *v)
+	} else {
+		var zero T
+		c.values = append(c.values, zero)
+	}
+	if c.added == c.n {
+		close(c.done)
+	}
+}
+
+// Wait waits for all the results and returns an [`Ok`] of the values in arrival order,
+// or returns an [`Err`] as soon as one result is an [`Err`].
+func (c *Collector[T]) Wait() *result.Result[[]T] {
+	select {
+	case <-c.done:
+	case <-c.failed:
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if len(c.errs) > 0 {
+		return result.Err[[]T](c.errs[0])
+	}
+	values := c.values
+	return result.Ok(&values)
+}
+
+// WaitAll waits for all the results and returns the values and the errors in arrival order.
+func (c *Collector[T]) WaitAll() ([]T, []error) {
+	<-c.done
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.values, c.errs
+}
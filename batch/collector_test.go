@@ -0,0 +1,32 @@
+package batch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+func TestCollector(t *testing.T) {
+	c := NewCollector[int](10)
+	for i := range 10 {
+		go func() {
+			c.Add(result.Ok(&i))
+		}()
+	}
+	if vs := c.Wait().Unwrap(); len(*vs) != 10 {
+		t.Error("Wait failed")
+	}
+
+	c = NewCollector[int](3)
+	x := 1
+	c.Add(result.Ok(&x))
+	c.Add(result.Err[int](errors.New("boom")))
+	if c.Wait().IsOk() {
+		t.Error("Wait failed to fail fast")
+	}
+	c.Add(result.Ok(&x))
+	if vs, errs := c.WaitAll(); len(vs) != 2 || len(errs) != 1 {
+		t.Error("WaitAll failed")
+	}
+}
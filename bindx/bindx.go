@@ -0,0 +1,112 @@
+// Package bindx binds HTTP requests to structs, independently of the web framework:
+// the `*http.Request` of net/http, gin (`c.Request`) and echo (`c.Request()`) all work.
+package bindx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"reflect"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/internal/fields"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// FieldError is the error of an input that could not be bound.
+type FieldError struct {
+	// Source is the source of the input: "path", "query", "header" or "body".
+	Source string
+	// Field is the name of the input, empty for the body.
+	Field string
+	Err   error
+}
+
+func (e *FieldError) Error() string {
+	if e.Field == "" {
+		return fmt.Sprintf("%s: %v", e.Source, e.Err)
+	}
+	return fmt.Sprintf("%s %s: %v", e.Source, e.Field, e.Err)
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// sources are the tags of the inputs, in binding order.
+var sources = []string{"path", "query", "header"}
+
+// Bind returns a struct populated from the request. A JSON body is decoded first, then the fields
+// tagged `path`, `query` or `header` are set from the path values of the `http.ServeMux` pattern,
+// the query parameters and the headers of that name. `Option` fields of absent inputs are left
+// [`None`], slice fields get all the values of their input.
+//
+// The errors of all the inputs that could not be bound are joined as `*FieldError` errors
+// tagged with `errkind.Invalid`.
+func Bind[T any](r *http.Request) *result.Result[T] {
+	v := new(T)
+	rv := reflect.ValueOf(v).Elem()
+	if rv.Kind() != reflect.Struct {
+		return result.Err[T](fmt.Errorf("bindx: %v is not a struct", rv.Type()))
+	}
+
+	var errs []error
+	if err := decodeBody(r, v); err != nil {
+		errs = append(errs, &FieldError{Source: "body", Err: err})
+	}
+	query := r.URL.Query()
+	t := rv.Type()
+	for i := range t.NumField() {
+		for _, source := range sources {
+			name := fields.Name(t.Field(i), source)
+			if name == "" || t.Field(i).Tag.Get(source) == "" {
+				continue
+			}
+			var vs []string
+			switch source {
+			case "path":
+				if s := r.PathValue(name); s != "" {
+					vs = []string{s}
+				}
+			case "query":
+				vs = query[name]
+			case "header":
+				vs = r.Header.Values(name)
+			}
+			if len(vs) == 0 {
+				continue
+			}
+			if err := fields.SetAll(rv.Field(i), vs); err != nil {
+				errs = append(errs, &FieldError{Source: source, Field: name, Err: err})
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return result.Err[T](errkind.Wrap(errkind.Invalid, errors.Join(errs...)))
+	}
+	return result.Ok(v)
+}
+
+// decodeBody decodes a JSON body, if any, into `v`.
+func decodeBody(r *http.Request, v any) error {
+	if r.Body == nil || r.Body == http.NoBody || r.ContentLength == 0 {
+		return nil
+	}
+	if ct := r.Header.Get("Content-Type"); ct != "" {
+		mediaType, _, err := mime.ParseMediaType(ct)
+		if err != nil {
+			return err
+		}
+		if mediaType != "application/json" {
+			return fmt.Errorf("unsupported content type %q", mediaType)
+		}
+	}
+	err := json.NewDecoder(r.Body).Decode(v)
+	if errors.Is(err, io.EOF) {
+		return nil
+	}
+	return err
+}
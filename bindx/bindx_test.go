@@ -0,0 +1,60 @@
+package bindx
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+type updateUser struct {
+	ID      int                   `path:"id"`
+	DryRun  option.Option[bool]   `query:"dry_run"`
+	Tags    []string              `query:"tag"`
+	Token   option.Option[string] `header:"X-Token"`
+	Name    string                `json:"name"`
+	Version option.Option[int]    `query:"v"`
+}
+
+func serve(t *testing.T, req *http.Request) *result.Result[updateUser] {
+	t.Helper()
+	var res *result.Result[updateUser]
+	mux := http.NewServeMux()
+	mux.HandleFunc("PUT /users/{id}", func(w http.ResponseWriter, r *http.Request) {
+		res = Bind[updateUser](r)
+	})
+	mux.ServeHTTP(httptest.NewRecorder(), req)
+	return res
+}
+
+func TestBind(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPut, "/users/7?dry_run=true&tag=a&tag=b", strings.NewReader(`{"name": "bob"}`))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Token", "secret")
+	u := serve(t, req).Unwrap()
+	if u.ID != 7 || !*u.DryRun.Unwrap("") || len(u.Tags) != 2 || *u.Token.Unwrap("") != "secret" || u.Name != "bob" {
+		t.Errorf("Bind failed: %+v", u)
+	}
+	if u.Version.IsSome() {
+		t.Error("Bind failed to leave an absent input None")
+	}
+}
+
+func TestBindErrors(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPut, "/users/x?v=y", strings.NewReader(`{`))
+	err := serve(t, req).UnwrapError()
+	var fe *FieldError
+	if !errors.Is(err, errkind.Invalid) || !errors.As(err, &fe) {
+		t.Fatalf("Bind failed to tag the error: %v", err)
+	}
+	for _, s := range []string{"body: ", "path id: ", "query v: "} {
+		if !strings.Contains(err.Error(), s) {
+			t.Errorf("Bind failed to report %q: %v", s, err)
+		}
+	}
+}
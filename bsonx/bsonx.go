@@ -0,0 +1,151 @@
+// Package bsonx encodes options and results as BSON for the MongoDB driver.
+// Codecs are registered per type on a registry:
+//
+//	reg := bson.NewRegistry()
+//	bsonx.RegisterOption[string](reg)
+//	bsonx.RegisterResult[int](reg)
+//
+// A [`None`] is encoded as null, and left out of documents by `omitempty`. A [`Some`] is encoded as its value.
+// An [`Ok`] is encoded as the document `{ok: value}`, and an [`Err`] as `{err: message}`.
+package bsonx
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"go.mongodb.org/mongo-driver/bson/bsoncodec"
+	"go.mongodb.org/mongo-driver/bson/bsonrw"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// RegisterOption registers the codec of `option.Option[T]` on the registry.
+func RegisterOption[T any](reg *bsoncodec.Registry) {
+	t := reflect.TypeFor[option.Option[T]]()
+	reg.RegisterTypeEncoder(t, bsoncodec.ValueEncoderFunc(encodeOption[T]))
+	reg.RegisterTypeDecoder(t, bsoncodec.ValueDecoderFunc(decodeOption[T]))
+}
+
+func encodeOption[T any](ec bsoncodec.EncodeContext, vw bsonrw.ValueWriter, val reflect.Value) error {
+	o := val.Interface().(option.Option[T])
+	if o.IsNone() {
+		return vw.WriteNull()
+	}
+	return encode(ec, vw, o.Unwrap(""))
+}
+
+func decodeOption[T any](dc bsoncodec.DecodeContext, vr bsonrw.ValueReader, val reflect.Value) error {
+	if vr.Type() == bsontype.Null {
+		val.Set(reflect.Zero(val.Type()))
+		return vr.ReadNull()
+	}
+	v, err := decode[T](dc, vr)
+	if err != nil {
+		return err
+	}
+	val.Set(reflect.ValueOf(*option.Some(v)))
+	return nil
+}
+
+// RegisterResult registers the codec of `result.Result[T]` on the registry.
+func RegisterResult[T any](reg *bsoncodec.Registry) {
+	t := reflect.TypeFor[result.Result[T]]()
+	reg.RegisterTypeEncoder(t, bsoncodec.ValueEncoderFunc(encodeResult[T]))
+	reg.RegisterTypeDecoder(t, bsoncodec.ValueDecoderFunc(decodeResult[T]))
+}
+
+func encodeResult[T any](ec bsoncodec.EncodeContext, vw bsonrw.ValueWriter, val reflect.Value) error {
+	r := val.Interface().(result.Result[T])
+	dw, err := vw.WriteDocument()
+	if err != nil {
+		return err
+	}
+	if r.IsErr() {
+		ew, err := dw.WriteDocumentElement("err")
+		if err != nil {
+			return err
+		}
+		if err := ew.WriteString(r.UnwrapError().Error()); err != nil {
+			return err
+		}
+		return dw.WriteDocumentEnd()
+	}
+	ew, err := dw.WriteDocumentElement("ok")
+	if err != nil {
+		return err
+	}
+	if v := r.Unwrap(); v == nil {
+		err = ew.WriteNull()
+	} else {
+		err = encode(ec, ew, v)
+	}
+	if err != nil {
+		return err
+	}
+	return dw.WriteDocumentEnd()
+}
+
+func decodeResult[T any](dc bsoncodec.DecodeContext, vr bsonrw.ValueReader, val reflect.Value) error {
+	dr, err := vr.ReadDocument()
+	if err != nil {
+		return err
+	}
+	var r *result.Result[T]
+	for {
+		key, evr, err := dr.ReadElement()
+		if errors.Is(err, bsonrw.ErrEOD) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		switch key {
+		case "ok":
+			if evr.Type() == bsontype.Null {
+				r = result.Ok[T](nil)
+				err = evr.ReadNull()
+				break
+			}
+			var v *T
+			v, err = decode[T](dc, evr)
+			r = result.Ok(v)
+		case "err":
+			var msg string
+			msg, err = evr.ReadString()
+			r = result.Err[T](errors.New(msg))
+		default:
+			err = evr.Skip()
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if r == nil {
+		return fmt.Errorf("bsonx: result document without ok or err element")
+	}
+	val.Set(reflect.ValueOf(*r))
+	return nil
+}
+
+func encode[T any](ec bsoncodec.EncodeContext, vw bsonrw.ValueWriter, v *T) error {
+	enc, err := ec.LookupEncoder(reflect.TypeFor[T]())
+	if err != nil {
+		return err
+	}
+	return enc.EncodeValue(ec, vw, reflect.ValueOf(v).Elem())
+}
+
+func decode[T any](dc bsoncodec.DecodeContext, vr bsonrw.ValueReader) (*T, error) {
+	dec, err := dc.LookupDecoder(reflect.TypeFor[T]())
+	if err != nil {
+		return nil, err
+	}
+	v := new(T)
+	if err := dec.DecodeValue(dc, vr, reflect.ValueOf(v).Elem()); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
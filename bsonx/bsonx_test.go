@@ -0,0 +1,63 @@
+package bsonx
+
+import (
+	"errors"
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+type user struct {
+	Name     string                `bson:"name"`
+	Nickname option.Option[string] `bson:"nickname"`
+	Email    option.Option[string] `bson:"email,omitempty"`
+	Tags     option.Option[[]string]
+	Age      result.Result[int] `bson:"age"`
+}
+
+func TestRoundTrip(t *testing.T) {
+	reg := bson.NewRegistry()
+	RegisterOption[string](reg)
+	RegisterOption[[]string](reg)
+	RegisterResult[int](reg)
+
+	nick, age := "bob", 42
+	in := user{Name: "Bob", Nickname: *option.Some(&nick), Tags: *option.Some(&[]string{"a"}), Age: *result.Ok(&age)}
+	b, err := bson.MarshalWithRegistry(reg, in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var raw bson.M
+	bson.Unmarshal(b, &raw)
+	if raw["nickname"] != "bob" || raw["age"].(bson.M)["ok"] != int32(42) {
+		t.Errorf("Marshal failed: %v", raw)
+	}
+	if _, ok := raw["email"]; ok {
+		t.Error("Marshal failed to omit a None")
+	}
+
+	var out user
+	if err := bson.UnmarshalWithRegistry(reg, b, &out); err != nil {
+		t.Fatal(err)
+	}
+	if *out.Nickname.Unwrap("") != "bob" || out.Email.IsSome() || (*out.Tags.Unwrap(""))[0] != "a" || *out.Age.Unwrap() != 42 {
+		t.Errorf("Unmarshal failed: %+v", out)
+	}
+
+	in = user{Age: *result.Err[int](errors.New("unknown"))}
+	b, _ = bson.MarshalWithRegistry(reg, in)
+	bson.Unmarshal(b, &raw)
+	if raw["nickname"] != nil || raw["age"].(bson.M)["err"] != "unknown" {
+		t.Errorf("Marshal failed: %v", raw)
+	}
+	out = user{Nickname: *option.Some(&nick)}
+	if err := bson.UnmarshalWithRegistry(reg, b, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Nickname.IsSome() || out.Age.UnwrapError().Error() != "unknown" {
+		t.Errorf("Unmarshal failed: %+v", out)
+	}
+}
@@ -0,0 +1,10 @@
+module github.com/yuanzicheng/go-result-and-option/bsonx
+
+go 1.25.0
+
+require (
+	github.com/yuanzicheng/go-result-and-option v0.0.0
+	go.mongodb.org/mongo-driver v1.17.6
+)
+
+replace github.com/yuanzicheng/go-result-and-option => ../
@@ -0,0 +1,38 @@
+package bufiox
+
+import (
+	"bufio"
+	"io"
+	"iter"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// Lines yields the lines of `r` without their end-of-line marker,
+// ending with an [`Err`] if reading fails.
+func Lines(r io.Reader) iter.Seq[*result.Result[string]] {
+	return Scan(r, bufio.ScanLines)
+}
+
+// Words yields the space-separated words of `r`, ending with an [`Err`] if reading fails.
+func Words(r io.Reader) iter.Seq[*result.Result[string]] {
+	return Scan(r, bufio.ScanWords)
+}
+
+// Scan yields the tokens of `r` delimited by the split function,
+// ending with an [`Err`] if reading or splitting fails.
+func Scan(r io.Reader, split bufio.SplitFunc) iter.Seq[*result.Result[string]] {
+	return func(yield func(*result.Result[string]) bool) {
+		s := bufio.NewScanner(r)
+		s.Split(split)
+		for s.Scan() {
+			token := s.Text()
+			if !yield(result.Ok(&token)) {
+				return
+			}
+		}
+		if err := s.Err(); err != nil {
+			yield(result.Err[string](err))
+		}
+	}
+}
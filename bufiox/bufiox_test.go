@@ -0,0 +1,41 @@
+package bufiox
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestLines(t *testing.T) {
+	var lines []string
+	for r := range Lines(strings.NewReader("a\nb c\n")) {
+		lines = append(lines, *r.Unwrap())
+	}
+	if len(lines) != 2 || lines[1] != "b c" {
+		t.Error("Lines failed")
+	}
+
+	n := 0
+	for range Words(strings.NewReader("a\nb c\n")) {
+		n++
+	}
+	if n != 3 {
+		t.Error("Words failed")
+	}
+}
+
+func TestLinesErr(t *testing.T) {
+	boom := errors.New("boom")
+	r := io.MultiReader(strings.NewReader("a\n"), iotest.ErrReader(boom))
+	var last error
+	for res := range Lines(r) {
+		if res.IsErr() {
+			last = res.UnwrapError()
+		}
+	}
+	if !errors.Is(last, boom) {
+		t.Error("Lines failed to end with the error")
+	}
+}
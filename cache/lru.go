@@ -0,0 +1,114 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+type item[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// LRU is a thread-safe cache holding at most a fixed number of entries,
+// evicting the least recently used one when full.
+type LRU[K comparable, V any] struct {
+	mu       sync.Mutex
+	capacity int
+	ll       *list.List
+	m        map[K]*list.Element
+}
+
+// NewLRU creates a cache holding at most `capacity` entries.
+func NewLRU[K comparable, V any](capacity int) *LRU[K, V] {
+	return &LRU[K, V]{
+		capacity: capacity,
+		ll:       list.New(),
+		m:        make(map[K]*list.Element),
+	}
+}
+
+func valueOf[K comparable, V any](e *list.Element) *option.Option[V] {
+	v := e.Value.(*item[K, V]).value
+	return option.Some(&v)
+}
+
+// Get returns the value of the key, marking it as recently used, or [`None`] if absent.
+func (c *LRU[K, V]) Get(k K) *option.Option[V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.m[k]
+	if !ok {
+		return option.None[V]()
+	}
+	c.ll.MoveToFront(e)
+	return valueOf[K, V](e)
+}
+
+// Peek returns the value of the key without marking it as recently used, or [`None`] if absent.
+func (c *LRU[K, V]) Peek(k K) *option.Option[V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.m[k]
+	if !ok {
+		return option.None[V]()
+	}
+	return valueOf[K, V](e)
+}
+
+// Put sets the value of the key, returning the evicted value if the cache was full.
+func (c *LRU[K, V]) Put(k K, v V) *option.Option[V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.m[k]; ok {
+		e.Value.(*item[K, V]).value = v
+		c.ll.MoveToFront(e)
+		return option.None[V]()
+	}
+	c.m[k] = c.ll.PushFront(&item[K, V]{key: k, value: v})
+	if c.capacity > 0 && c.ll.Len() > c.capacity {
+		return c.remove(c.ll.Back())
+	}
+	return option.None[V]()
+}
+
+// Remove removes the key, returning its value if it was present.
+func (c *LRU[K, V]) Remove(k K) *option.Option[V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.m[k]
+	if !ok {
+		return option.None[V]()
+	}
+	return c.remove(e)
+}
+
+// GetOrLoad returns the value of the key if present, otherwise calls `loader`
+// and caches its [`Ok`] value. An [`Err`] is returned without being cached.
+// Concurrent calls for a missing key may call `loader` more than once.
+func (c *LRU[K, V]) GetOrLoad(k K, loader func(K) *result.Result[V]) *result.Result[V] {
+	if o := c.Get(k); o.IsSome() {
+		return result.Ok(o.UnwrapOrDefault())
+	}
+	r := loader(k)
+	if r.IsOkAndNotNil() {
+		c.Put(k, *r.Unwrap())
+	}
+	return r
+}
+
+// Len returns the number of cached entries.
+func (c *LRU[K, V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.ll.Len()
+}
+
+func (c *LRU[K, V]) remove(e *list.Element) *option.Option[V] {
+	it := c.ll.Remove(e).(*item[K, V])
+	delete(c.m, it.key)
+	return option.Some(&it.value)
+}
@@ -0,0 +1,46 @@
+package cache
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+func TestLRU(t *testing.T) {
+	c := NewLRU[string, int](2)
+	c.Put("a", 1)
+	c.Put("b", 2)
+	c.Get("a")
+	if evicted := c.Put("c", 3); *evicted.Unwrap("") != 2 {
+		t.Error("Put failed to evict the least recently used entry")
+	}
+	if !c.Peek("b").IsNone() || *c.Peek("a").Unwrap("") != 1 {
+		t.Error("Peek failed")
+	}
+	if removed := c.Remove("a"); *removed.Unwrap("") != 1 || c.Len() != 1 {
+		t.Error("Remove failed")
+	}
+}
+
+func TestGetOrLoad(t *testing.T) {
+	c := NewLRU[string, int](2)
+	calls := 0
+	loader := func(k string) *result.Result[int] {
+		calls++
+		if k == "bad" {
+			return result.Err[int](errors.New("bad key"))
+		}
+		n := len(k)
+		return result.Ok(&n)
+	}
+
+	c.GetOrLoad("abc", loader)
+	if r := c.GetOrLoad("abc", loader); *r.Unwrap() != 3 || calls != 1 {
+		t.Error("GetOrLoad failed")
+	}
+	c.GetOrLoad("bad", loader)
+	if c.GetOrLoad("bad", loader).IsOk() || calls != 3 {
+		t.Error("GetOrLoad cached an Err")
+	}
+}
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+)
+
+const errkindPath = "github.com/yuanzicheng/go-result-and-option/errkind"
+
+// kind is a case of the switch: the field of KindCases and the expression of the kind.
+type kind struct {
+	field, expr string
+}
+
+// Generate returns the source of the switch over the kinds of package errkind and those declared
+// in the package in `dir`, skipping the previously generated file `output`.
+func Generate(dir, output string) ([]byte, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	var kinds []kind
+	seen := map[string]bool{}
+	for _, k := range errkind.Kinds() {
+		name := fieldName(string(k))
+		seen[name] = true
+		kinds = append(kinds, kind{field: name, expr: "errkind." + name})
+	}
+
+	pkg := ""
+	fset := token.NewFileSet()
+	for _, e := range entries {
+		if e.IsDir() || e.Name() == output || !strings.HasSuffix(e.Name(), ".go") || strings.HasSuffix(e.Name(), "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, filepath.Join(dir, e.Name()), nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		pkg = f.Name.Name
+		for _, name := range localKinds(f) {
+			if !seen[name] {
+				seen[name] = true
+				kinds = append(kinds, kind{field: name, expr: name})
+			}
+		}
+	}
+	if pkg == "" {
+		return nil, fmt.Errorf("no Go files in %s", dir)
+	}
+	if pkg == "errkind" {
+		return nil, fmt.Errorf("can't generate a switch in package errkind itself")
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by kindswitch. DO NOT EDIT.\n\npackage %s\n\nimport %q\n\n", pkg, errkindPath)
+	b.WriteString("// KindCases holds the handlers of the error kinds for SwitchKind.\ntype KindCases[R any] struct {\n")
+	for _, k := range kinds {
+		fmt.Fprintf(&b, "\t%s func(error) R\n", k.field)
+	}
+	b.WriteString("\t// Other handles the errors of any other kind or without a kind.\n\tOther func(error) R\n}\n\n")
+	b.WriteString("// SwitchKind calls the handler of the kind of `err`, see errkind.KindOf.\n" +
+		"// A nil handler falls back to Other, and a nil Other returns the zero value of R.\n" +
+		"func SwitchKind[R any](err error, c KindCases[R]) R {\n\th := c.Other\n\tswitch errkind.KindOf(err) {\n")
+	for _, k := range kinds {
+		fmt.Fprintf(&b, "\tcase %s:\n\t\tif c.%s != nil {\n\t\t\th = c.%s\n\t\t}\n", k.expr, k.field, k.field)
+	}
+	b.WriteString("\t}\n\tif h == nil {\n\t\tvar zero R\n\t\treturn zero\n\t}\n\treturn h(err)\n}\n")
+	return format.Source(b.Bytes())
+}
+
+// localKinds returns the names of the constants of type `errkind.Kind` declared in the file.
+func localKinds(f *ast.File) []string {
+	local := ""
+	for _, imp := range f.Imports {
+		if path, _ := strconv.Unquote(imp.Path.Value); path == errkindPath {
+			local = "errkind"
+			if imp.Name != nil {
+				local = imp.Name.Name
+			}
+		}
+	}
+	if local == "" {
+		return nil
+	}
+	var names []string
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			vs := spec.(*ast.ValueSpec)
+			sel, ok := vs.Type.(*ast.SelectorExpr)
+			if !ok || sel.Sel.Name != "Kind" {
+				continue
+			}
+			if id, ok := sel.X.(*ast.Ident); !ok || id.Name != local {
+				continue
+			}
+			for _, id := range vs.Names {
+				if id.IsExported() {
+					names = append(names, id.Name)
+				}
+			}
+		}
+	}
+	return names
+}
+
+// fieldName returns the Go name of a kind value, e.g. NotFound for "not_found".
+func fieldName(value string) string {
+	var b strings.Builder
+	for _, part := range strings.Split(value, "_") {
+		if part != "" {
+			b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	src, err := Generate("testdata/billing", "kind_switch.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden, _ := os.ReadFile("testdata/kind_switch.go.golden")
+	if string(src) != string(golden) {
+		t.Errorf("Generate failed:\n%s", src)
+	}
+	if _, err := Generate("testdata/missing", "kind_switch.go"); err == nil {
+		t.Error("Generate succeeded without a package")
+	}
+	if fieldName("not_found") != "NotFound" || fieldName("internal") != "Internal" {
+		t.Error("fieldName failed")
+	}
+	if testing.Short() {
+		return
+	}
+
+	// Build the generated switch with the package against the library.
+	root, _ := filepath.Abs("../..")
+	dir := t.TempDir()
+	billing, _ := os.ReadFile("testdata/billing/billing.go")
+	os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module billing\n\ngo 1.23\n\nrequire github.com/yuanzicheng/go-result-and-option v0.0.0\n\nreplace github.com/yuanzicheng/go-result-and-option => "+root+"\n"), 0o644)
+	os.WriteFile(filepath.Join(dir, "billing.go"), billing, 0o644)
+	os.WriteFile(filepath.Join(dir, "kind_switch.go"), src, 0o644)
+	os.WriteFile(filepath.Join(dir, "billing_test.go"), []byte(`package billing
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+)
+
+func TestSwitchKind(t *testing.T) {
+	status := func(code int) func(error) int { return func(error) int { return code } }
+	c := KindCases[int]{NotFound: status(404), PaymentDeclined: status(402), Other: status(500)}
+	if SwitchKind(errkind.Wrap(errkind.NotFound, errors.New("x")), c) != 404 {
+		t.Error("NotFound was not handled")
+	}
+	if SwitchKind(errkind.Wrap(PaymentDeclined, errors.New("x")), c) != 402 {
+		t.Error("PaymentDeclined was not handled")
+	}
+	if SwitchKind(errkind.Wrap(errkind.Timeout, errors.New("x")), c) != 500 || SwitchKind(errors.New("x"), c) != 500 {
+		t.Error("Other was not used")
+	}
+	if SwitchKind(errors.New("x"), KindCases[int]{}) != 0 {
+		t.Error("nil Other did not return the zero value")
+	}
+}
+`), 0o644)
+	cmd := exec.Command("go", "test", "./...")
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Errorf("generated switch doesn't work: %v\n%s", err, out)
+	}
+}
@@ -0,0 +1,34 @@
+// Command kindswitch generates an exhaustive switch over the error kinds: given the kinds of
+// package errkind and the constants of type `errkind.Kind` declared in a package, it writes
+// a `KindCases` struct with a handler field per kind and a SwitchKind function calling the
+// handler of the kind of an error. The kindswitch analyzer of resultcheck reports `KindCases`
+// literals missing a kind, so the handling stays exhaustive as kinds are added.
+//
+// Usage, in the package declaring its own kinds, if any:
+//
+//	//go:generate go run github.com/yuanzicheng/go-result-and-option/cmd/kindswitch
+//
+// The switch is written to `kind_switch.go` in the same directory.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	dir := flag.String("dir", ".", "directory of the package")
+	output := flag.String("output", "kind_switch.go", "name of the generated file, in the directory of the package")
+	flag.Parse()
+	src, err := Generate(*dir, *output)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "kindswitch:", err)
+		os.Exit(1)
+	}
+	if err := os.WriteFile(filepath.Join(*dir, *output), src, 0o644); err != nil {
+		fmt.Fprintln(os.Stderr, "kindswitch:", err)
+		os.Exit(1)
+	}
+}
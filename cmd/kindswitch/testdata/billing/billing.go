@@ -0,0 +1,13 @@
+package billing
+
+import "github.com/yuanzicheng/go-result-and-option/errkind"
+
+const (
+	// PaymentDeclined means the payment was refused by the provider.
+	PaymentDeclined errkind.Kind = "payment_declined"
+	// QuotaExceeded means the account ran out of credits.
+	QuotaExceeded errkind.Kind = "quota_exceeded"
+	internalOnly  errkind.Kind = "internal_only"
+)
+
+var _ = internalOnly
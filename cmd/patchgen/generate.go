@@ -0,0 +1,128 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const optionPath = "github.com/yuanzicheng/go-result-and-option/option"
+
+// Generate returns the source of the patch of the struct type `name` declared in the package in `dir`.
+func Generate(dir, name string) ([]byte, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	fset := token.NewFileSet()
+	for _, e := range entries {
+		if e.IsDir() || !strings.HasSuffix(e.Name(), ".go") || strings.HasSuffix(e.Name(), "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, filepath.Join(dir, e.Name()), nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		if st := findStruct(f, name); st != nil {
+			return generate(fset, f, f.Name.Name, name, st)
+		}
+	}
+	return nil, fmt.Errorf("struct type %s not found in %s", name, dir)
+}
+
+func findStruct(f *ast.File, name string) *ast.StructType {
+	var st *ast.StructType
+	ast.Inspect(f, func(n ast.Node) bool {
+		if ts, ok := n.(*ast.TypeSpec); ok && ts.Name.Name == name && ts.TypeParams == nil {
+			st, _ = ts.Type.(*ast.StructType)
+		}
+		return st == nil
+	})
+	return st
+}
+
+type field struct {
+	name, typ, tag string
+}
+
+func generate(fset *token.FileSet, f *ast.File, pkg, name string, st *ast.StructType) ([]byte, error) {
+	var fields []field
+	used := map[string]bool{}
+	for _, fd := range st.Fields.List {
+		var typ bytes.Buffer
+		if err := format.Node(&typ, fset, fd.Type); err != nil {
+			return nil, err
+		}
+		ast.Inspect(fd.Type, func(n ast.Node) bool {
+			if sel, ok := n.(*ast.SelectorExpr); ok {
+				if id, ok := sel.X.(*ast.Ident); ok {
+					used[id.Name] = true
+				}
+			}
+			return true
+		})
+		var tag string
+		if fd.Tag != nil {
+			tag = fd.Tag.Value
+		}
+		for _, id := range fd.Names {
+			if id.IsExported() {
+				fields = append(fields, field{name: id.Name, typ: typ.String(), tag: tag})
+			}
+		}
+	}
+
+	// Keep the imports of the source file used by the field types,
+	// standard library imports first.
+	std, imports := []string{}, []string{strconv.Quote(optionPath)}
+	for _, imp := range f.Imports {
+		path, _ := strconv.Unquote(imp.Path.Value)
+		local := path[strings.LastIndex(path, "/")+1:]
+		if imp.Name != nil {
+			local = imp.Name.Name
+		}
+		if used[local] && path != optionPath {
+			spec := imp.Path.Value
+			if imp.Name != nil {
+				spec = imp.Name.Name + " " + spec
+			}
+			if strings.Contains(strings.Split(path, "/")[0], ".") {
+				imports = append(imports, spec)
+			} else {
+				std = append(std, spec)
+			}
+		}
+	}
+	byPath := func(a, b string) int {
+		return strings.Compare(a[strings.Index(a, `"`):], b[strings.Index(b, `"`):])
+	}
+	slices.SortFunc(std, byPath)
+	slices.SortFunc(imports, byPath)
+	if len(std) > 0 {
+		imports = append(append(std, ""), imports...)
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by patchgen -type %s. DO NOT EDIT.\n\npackage %s\n\nimport (\n", name, pkg)
+	for _, imp := range imports {
+		fmt.Fprintf(&b, "\t%s\n", imp)
+	}
+	fmt.Fprintf(&b, ")\n\n// %sPatch is a partial update of a %s, its [`None`] fields being left unchanged.\ntype %sPatch struct {\n", name, name, name)
+	for _, fd := range fields {
+		fmt.Fprintf(&b, "\t%s option.Option[%s] %s\n", fd.name, fd.typ, fd.tag)
+	}
+	fmt.Fprintf(&b, "}\n\n// Apply sets the fields of `v` to the [`Some`] values of the patch.\nfunc (p *%sPatch) Apply(v *%s) {\n", name, name)
+	for _, fd := range fields {
+		fmt.Fprintf(&b, "\tif p.%s.IsSome() {\n\t\tv.%s = *p.%s.Unwrap(\"\")\n\t}\n", fd.name, fd.name, fd.name)
+	}
+	b.WriteString("}\n")
+	return format.Source(b.Bytes())
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	src, err := Generate("testdata/user", "User")
+	if err != nil {
+		t.Fatal(err)
+	}
+	golden, _ := os.ReadFile("testdata/user_patch.go.golden")
+	if string(src) != string(golden) {
+		t.Errorf("Generate failed:\n%s", src)
+	}
+	if _, err := Generate("testdata/user", "Missing"); err == nil {
+		t.Error("Generate found a missing type")
+	}
+	if testing.Short() {
+		return
+	}
+
+	// Build the generated patch with the struct against the library.
+	root, _ := filepath.Abs("../..")
+	dir := t.TempDir()
+	user, _ := os.ReadFile("testdata/user/user.go")
+	os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module user\n\ngo 1.23\n\nrequire github.com/yuanzicheng/go-result-and-option v0.0.0\n\nreplace github.com/yuanzicheng/go-result-and-option => "+root+"\n"), 0o644)
+	os.WriteFile(filepath.Join(dir, "user.go"), user, 0o644)
+	os.WriteFile(filepath.Join(dir, "user_patch.go"), src, 0o644)
+	os.WriteFile(filepath.Join(dir, "user_test.go"), []byte(`package user
+
+import (
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+)
+
+func TestApply(t *testing.T) {
+	u := User{Name: "ann", Age: 30}
+	name, email := "bob", option.None[string]()
+	p := UserPatch{Name: *option.Some(&name), Email: *option.Some(email)}
+	p.Apply(&u)
+	if u.Name != "bob" || u.Age != 30 || u.Email.IsSome() {
+		t.Errorf("Apply failed: %+v", u)
+	}
+}
+`), 0o644)
+	cmd := exec.Command("go", "test", "./...")
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Errorf("generated patch doesn't work: %v\n%s", err, out)
+	}
+}
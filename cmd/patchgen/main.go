@@ -0,0 +1,41 @@
+// Command patchgen generates partial-update structs: given `type User struct{...}`,
+// it writes a `UserPatch` struct with an `option.Option` field for each exported field
+// of User, keeping their tags, and an Apply method setting the fields of a User
+// to the [`Some`] values of the patch.
+//
+// Usage, in the file declaring User:
+//
+//	//go:generate go run github.com/yuanzicheng/go-result-and-option/cmd/patchgen -type User
+//
+// The patch is written to `user_patch.go` in the same directory.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	typ := flag.String("type", "", "comma-separated struct types to generate patches for")
+	dir := flag.String("dir", ".", "directory of the package declaring the types")
+	flag.Parse()
+	if *typ == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	for _, t := range strings.Split(*typ, ",") {
+		src, err := Generate(*dir, t)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "patchgen:", err)
+			os.Exit(1)
+		}
+		out := filepath.Join(*dir, strings.ToLower(t)+"_patch.go")
+		if err := os.WriteFile(out, src, 0o644); err != nil {
+			fmt.Fprintln(os.Stderr, "patchgen:", err)
+			os.Exit(1)
+		}
+	}
+}
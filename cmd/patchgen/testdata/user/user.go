@@ -0,0 +1,17 @@
+package user
+
+import (
+	"net/netip"
+	t "time"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+)
+
+type User struct {
+	Name       string `json:"name"`
+	Age, Score int
+	Email      option.Option[string] `json:"email"`
+	Created    t.Time
+	Addrs      []netip.Addr
+	secret     string
+}
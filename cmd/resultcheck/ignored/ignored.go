@@ -0,0 +1,47 @@
+// Package ignored defines an analyzer reporting discarded results and options.
+package ignored
+
+import (
+	"go/ast"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"github.com/yuanzicheng/go-result-and-option/cmd/resultcheck/internal/resulttype"
+)
+
+// Analyzer reports calls whose `*result.Result` or `*option.Option` value is discarded,
+// like errcheck does for errors. Inspect and InspectErr calls, which return their receiver,
+// are not reported.
+var Analyzer = &analysis.Analyzer{
+	Name:     "ignored",
+	Doc:      "report discarded results and options",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	ins := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	ins.Preorder([]ast.Node{(*ast.ExprStmt)(nil)}, func(n ast.Node) {
+		call, ok := n.(*ast.ExprStmt).X.(*ast.CallExpr)
+		if !ok || isInspect(pass, call) {
+			return
+		}
+		if name := resulttype.Of(pass.TypesInfo.TypeOf(call)); name != "" {
+			pass.Reportf(call.Pos(), "%s value is discarded", name)
+		}
+	})
+	return nil, nil
+}
+
+func isInspect(pass *analysis.Pass, call *ast.CallExpr) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	if sel.Sel.Name != "Inspect" && sel.Sel.Name != "InspectErr" {
+		return false
+	}
+	return resulttype.Of(pass.TypesInfo.TypeOf(sel.X)) != ""
+}
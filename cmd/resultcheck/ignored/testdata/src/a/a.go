@@ -0,0 +1,22 @@
+package a
+
+import (
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+func save(x *int) *result.Result[int] { return result.Ok(x) }
+
+func find() *option.Option[int] { return nil }
+
+func f(x *int) {
+	save(x) // want `Result value is discarded`
+	find()  // want `Option value is discarded`
+	result.Ok(x).Inspect(nil)
+	save(x).Inspect(func(*int) {}).InspectErr(func(error) {})
+	_ = save(x)
+	if save(x).IsOk() && find().IsSome() {
+		save(x).Unwrap()
+	}
+	defer save(x)
+}
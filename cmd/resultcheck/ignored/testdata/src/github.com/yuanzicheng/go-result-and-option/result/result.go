@@ -0,0 +1,13 @@
+package result
+
+type Result[T any] struct{}
+
+func Ok[T any](v *T) *Result[T] { return nil }
+
+func (r *Result[T]) IsOk() bool { return true }
+
+func (r *Result[T]) Inspect(f func(*T)) *Result[T] { return r }
+
+func (r *Result[T]) InspectErr(f func(error)) *Result[T] { return r }
+
+func (r *Result[T]) Unwrap() *T { return nil }
@@ -0,0 +1,26 @@
+// Package resulttype identifies result and option types for the analyzers.
+package resulttype
+
+import "go/types"
+
+const (
+	resultPath = "github.com/yuanzicheng/go-result-and-option/result"
+	optionPath = "github.com/yuanzicheng/go-result-and-option/option"
+)
+
+// Of returns "Result" or "Option" if `t` is a pointer to a result or an option, otherwise "".
+func Of(t types.Type) string {
+	ptr, ok := t.(*types.Pointer)
+	if !ok {
+		return ""
+	}
+	named, ok := ptr.Elem().(*types.Named)
+	if !ok || named.Obj().Pkg() == nil {
+		return ""
+	}
+	switch path, name := named.Obj().Pkg().Path(), named.Obj().Name(); {
+	case path == resultPath && name == "Result", path == optionPath && name == "Option":
+		return name
+	}
+	return ""
+}
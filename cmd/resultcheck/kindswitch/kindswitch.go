@@ -0,0 +1,126 @@
+// Package kindswitch defines an analyzer reporting non-exhaustive handling of error kinds.
+package kindswitch
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+)
+
+const errkindPath = "github.com/yuanzicheng/go-result-and-option/errkind"
+
+// Analyzer reports the handling of error kinds missing some of them:
+//
+//   - `switch` statements over an `errkind.Kind` without a default clause, missing a case for
+//     a kind of package errkind or of the analyzed package;
+//   - keyed `KindCases` literals, as generated by cmd/kindswitch, missing a handler besides Other.
+var Analyzer = &analysis.Analyzer{
+	Name:     "kindswitch",
+	Doc:      "report switches over error kinds and KindCases literals missing a kind",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	ins := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	ins.Preorder([]ast.Node{(*ast.SwitchStmt)(nil), (*ast.CompositeLit)(nil)}, func(n ast.Node) {
+		switch n := n.(type) {
+		case *ast.SwitchStmt:
+			checkSwitch(pass, n)
+		case *ast.CompositeLit:
+			checkCases(pass, n)
+		}
+	})
+	return nil, nil
+}
+
+// isKind reports whether `t` is `errkind.Kind`.
+func isKind(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	return ok && named.Obj().Pkg() != nil && named.Obj().Pkg().Path() == errkindPath && named.Obj().Name() == "Kind"
+}
+
+// kindConsts returns the constants of type `errkind.Kind` declared in the scope.
+func kindConsts(scope *types.Scope) []*types.Const {
+	var consts []*types.Const
+	for _, name := range scope.Names() {
+		if c, ok := scope.Lookup(name).(*types.Const); ok && isKind(c.Type()) {
+			consts = append(consts, c)
+		}
+	}
+	return consts
+}
+
+func checkSwitch(pass *analysis.Pass, s *ast.SwitchStmt) {
+	if s.Tag == nil || !isKind(pass.TypesInfo.TypeOf(s.Tag)) {
+		return
+	}
+	covered := map[string]bool{}
+	for _, stmt := range s.Body.List {
+		clause := stmt.(*ast.CaseClause)
+		if clause.List == nil {
+			return
+		}
+		for _, e := range clause.List {
+			if tv, ok := pass.TypesInfo.Types[e]; ok && tv.Value != nil && tv.Value.Kind() == constant.String {
+				covered[constant.StringVal(tv.Value)] = true
+			}
+		}
+	}
+	named := pass.TypesInfo.TypeOf(s.Tag).(*types.Named)
+	consts := kindConsts(named.Obj().Pkg().Scope())
+	if pass.Pkg.Path() != errkindPath {
+		consts = append(consts, kindConsts(pass.Pkg.Scope())...)
+	}
+	var missing []string
+	for _, c := range consts {
+		if !covered[constant.StringVal(c.Val())] {
+			covered[constant.StringVal(c.Val())] = true
+			name := c.Name()
+			if c.Pkg() != pass.Pkg {
+				name = c.Pkg().Name() + "." + name
+			}
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		pass.Reportf(s.Pos(), "switch over errkind.Kind is missing %s", strings.Join(missing, ", "))
+	}
+}
+
+func checkCases(pass *analysis.Pass, lit *ast.CompositeLit) {
+	named, ok := pass.TypesInfo.TypeOf(lit).(*types.Named)
+	if !ok || named.Obj().Name() != "KindCases" {
+		return
+	}
+	st, ok := named.Underlying().(*types.Struct)
+	if !ok {
+		return
+	}
+	set := map[string]bool{}
+	for _, e := range lit.Elts {
+		kv, ok := e.(*ast.KeyValueExpr)
+		if !ok {
+			return
+		}
+		if id, ok := kv.Key.(*ast.Ident); ok {
+			set[id.Name] = true
+		}
+	}
+	var missing []string
+	for f := range st.Fields() {
+		if f.Name() != "Other" && !set[f.Name()] {
+			missing = append(missing, f.Name())
+		}
+	}
+	slices.Sort(missing)
+	if len(missing) > 0 {
+		pass.Reportf(lit.Pos(), "KindCases literal is missing %s", strings.Join(missing, ", "))
+	}
+}
@@ -0,0 +1,39 @@
+package a
+
+import "github.com/yuanzicheng/go-result-and-option/errkind"
+
+const PaymentDeclined errkind.Kind = "payment_declined"
+
+type KindCases[R any] struct {
+	NotFound        func(error) R
+	Invalid         func(error) R
+	Timeout         func(error) R
+	PaymentDeclined func(error) R
+	Other           func(error) R
+}
+
+func status(err error) int {
+	switch errkind.KindOf(err) { // want `switch over errkind.Kind is missing errkind.Timeout, PaymentDeclined`
+	case errkind.NotFound:
+		return 404
+	case errkind.Invalid:
+		return 400
+	}
+	switch errkind.KindOf(err) {
+	case errkind.NotFound, errkind.Invalid, errkind.Timeout, PaymentDeclined:
+		return 400
+	}
+	switch errkind.KindOf(err) {
+	case errkind.NotFound:
+		return 404
+	default:
+		return 500
+	}
+}
+
+func cases() {
+	h := func(error) int { return 0 }
+	_ = KindCases[int]{NotFound: h, Other: h} // want `KindCases literal is missing Invalid, PaymentDeclined, Timeout`
+	_ = KindCases[int]{NotFound: h, Invalid: h, Timeout: h, PaymentDeclined: h}
+	_ = KindCases[int]{h, h, h, h, h}
+}
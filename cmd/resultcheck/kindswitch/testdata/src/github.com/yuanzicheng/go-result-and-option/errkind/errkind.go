@@ -0,0 +1,13 @@
+package errkind
+
+type Kind string
+
+const (
+	NotFound Kind = "not_found"
+	Invalid  Kind = "invalid"
+	Timeout  Kind = "timeout"
+)
+
+func (k Kind) Error() string { return string(k) }
+
+func KindOf(err error) Kind { return "" }
@@ -0,0 +1,18 @@
+// Command resultcheck reports misuses of results and options.
+//
+// Usage:
+//
+//	go vet -vettool=$(which resultcheck) ./...
+package main
+
+import (
+	"golang.org/x/tools/go/analysis/multichecker"
+
+	"github.com/yuanzicheng/go-result-and-option/cmd/resultcheck/ignored"
+	"github.com/yuanzicheng/go-result-and-option/cmd/resultcheck/kindswitch"
+	"github.com/yuanzicheng/go-result-and-option/cmd/resultcheck/unguarded"
+)
+
+func main() {
+	multichecker.Main(ignored.Analyzer, kindswitch.Analyzer, unguarded.Analyzer)
+}
@@ -0,0 +1,62 @@
+package a
+
+import (
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+type holder struct{ r *result.Result[int] }
+
+func load() *result.Result[int] { return nil }
+
+func unguarded(r *result.Result[int], o *option.Option[int]) {
+	r.Unwrap()        // want `Result.Unwrap may panic`
+	o.Unwrap("")      // want `Option.Unwrap may panic`
+	load().Expect("") // want `Result.Expect may panic`
+	if o.IsSome() {
+		r.Unwrap() // want `Result.Unwrap may panic`
+	}
+	if r.IsErr() {
+		r.Unwrap() // want `Result.Unwrap may panic`
+	}
+	if r.IsOk() {
+		func() {
+			r.Unwrap() // want `Result.Unwrap may panic`
+		}()
+	}
+	if r.IsOk() || o.IsSome() {
+		r.Unwrap() // want `Result.Unwrap may panic`
+	}
+}
+
+func guarded(r *result.Result[int], o *option.Option[int], h holder) {
+	if r.IsOk() {
+		r.Unwrap()
+	}
+	if o.IsSome() && r.IsOkAnd(nil) {
+		o.Unwrap("")
+		r.Expect("")
+	}
+	if r.IsErr() {
+		return
+	} else {
+		r.Unwrap()
+	}
+	if h.r.IsOk() && *h.r.Unwrap() > 0 {
+		return
+	}
+	r.Unwrap() //resultcheck:ignore
+	//nolint:resultcheck
+	o.Unwrap("")
+}
+
+func earlyReturn(r *result.Result[int], o *option.Option[int]) {
+	if o.IsNone() {
+		panic("none")
+	}
+	if !r.IsOk() {
+		return
+	}
+	r.Unwrap()
+	o.Unwrap("")
+}
@@ -0,0 +1,11 @@
+package option
+
+type Option[T any] struct{}
+
+func Some[T any](v *T) *Option[T] { return nil }
+
+func (o *Option[T]) IsSome() bool { return true }
+
+func (o *Option[T]) IsNone() bool { return false }
+
+func (o *Option[T]) Unwrap(msg string) *T { return nil }
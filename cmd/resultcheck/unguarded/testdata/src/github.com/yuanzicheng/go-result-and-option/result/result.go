@@ -0,0 +1,15 @@
+package result
+
+type Result[T any] struct{}
+
+func Ok[T any](v *T) *Result[T] { return nil }
+
+func (r *Result[T]) IsOk() bool { return true }
+
+func (r *Result[T]) IsErr() bool { return false }
+
+func (r *Result[T]) IsOkAnd(f func(*T) bool) bool { return true }
+
+func (r *Result[T]) Unwrap() *T { return nil }
+
+func (r *Result[T]) Expect(msg string) *T { return nil }
@@ -0,0 +1,203 @@
+// Package unguarded defines an analyzer reporting Unwrap and Expect calls
+// not guarded by a check of the result or option.
+package unguarded
+
+import (
+	"go/ast"
+	"go/token"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/inspect"
+	"golang.org/x/tools/go/ast/inspector"
+
+	"github.com/yuanzicheng/go-result-and-option/cmd/resultcheck/internal/resulttype"
+)
+
+// Analyzer reports `Unwrap` and `Expect` calls on results and options which may panic,
+// i.e. which are not guarded by an IsOk or IsSome check of the same expression:
+//
+//   - in the body of an `if` whose condition checks IsOk, IsSome or their And variants,
+//     possibly among other `&&` operands, or in the else branch of an IsErr or IsNone check;
+//   - after an `if` checking IsErr, IsNone or a negated IsOk or IsSome whose body
+//     returns, branches, panics or fails the test;
+//   - in the right operand of a `&&` whose left operand checks IsOk or IsSome.
+//
+// Reassignments between the check and the call are not tracked. Calls can be
+// suppressed with a `//resultcheck:ignore` or `//nolint:resultcheck` comment on
+// the line of the call or the line above.
+var Analyzer = &analysis.Analyzer{
+	Name:     "unguarded",
+	Doc:      "report Unwrap and Expect calls not guarded by an IsOk or IsSome check",
+	Requires: []*analysis.Analyzer{inspect.Analyzer},
+	Run:      run,
+}
+
+func run(pass *analysis.Pass) (any, error) {
+	suppressed := suppressedLines(pass)
+	ins := pass.ResultOf[inspect.Analyzer].(*inspector.Inspector)
+	ins.WithStack([]ast.Node{(*ast.CallExpr)(nil)}, func(n ast.Node, push bool, stack []ast.Node) bool {
+		if !push {
+			return true
+		}
+		sel, ok := n.(*ast.CallExpr).Fun.(*ast.SelectorExpr)
+		if !ok || (sel.Sel.Name != "Unwrap" && sel.Sel.Name != "Expect") {
+			return true
+		}
+		name := resulttype.Of(pass.TypesInfo.TypeOf(sel.X))
+		if name == "" {
+			return true
+		}
+		pos := pass.Fset.Position(n.Pos())
+		if suppressed[lineKey{pos.Filename, pos.Line}] || suppressed[lineKey{pos.Filename, pos.Line - 1}] {
+			return true
+		}
+		if key, ok := exprKey(sel.X); ok && guarded(key, stack) {
+			return true
+		}
+		pass.Reportf(n.Pos(), "%s.%s may panic: it is not guarded by a check", name, sel.Sel.Name)
+		return true
+	})
+	return nil, nil
+}
+
+type lineKey struct {
+	file string
+	line int
+}
+
+func suppressedLines(pass *analysis.Pass) map[lineKey]bool {
+	lines := make(map[lineKey]bool)
+	for _, f := range pass.Files {
+		for _, group := range f.Comments {
+			for _, c := range group.List {
+				if strings.HasPrefix(c.Text, "//resultcheck:ignore") || strings.HasPrefix(c.Text, "//nolint:resultcheck") {
+					pos := pass.Fset.Position(c.Pos())
+					lines[lineKey{pos.Filename, pos.Line}] = true
+				}
+			}
+		}
+	}
+	return lines
+}
+
+// exprKey returns a key identifying the expression, only for identifiers and selectors of identifiers,
+// whose checks can be tracked.
+func exprKey(e ast.Expr) (string, bool) {
+	switch e := e.(type) {
+	case *ast.Ident:
+		return e.Name, true
+	case *ast.SelectorExpr:
+		if k, ok := exprKey(e.X); ok {
+			return k + "." + e.Sel.Name, true
+		}
+	case *ast.ParenExpr:
+		return exprKey(e.X)
+	}
+	return "", false
+}
+
+// guarded reports whether the innermost node of the stack is guarded by a check of `key`.
+func guarded(key string, stack []ast.Node) bool {
+	for i := len(stack) - 1; i > 0; i-- {
+		child, parent := stack[i], stack[i-1]
+		switch p := parent.(type) {
+		case *ast.IfStmt:
+			if child == p.Body && ensuresOk(key, p.Cond) {
+				return true
+			}
+			if child == p.Else && ensuresNotOk(key, p.Cond) {
+				return true
+			}
+		case *ast.BinaryExpr:
+			if p.Op == token.LAND && child == p.Y && ensuresOk(key, p.X) {
+				return true
+			}
+		case *ast.BlockStmt:
+			for _, stmt := range p.List {
+				if stmt == child {
+					break
+				}
+				if s, ok := stmt.(*ast.IfStmt); ok && s.Else == nil && ensuresNotOk(key, s.Cond) && terminates(s.Body) {
+					return true
+				}
+			}
+		case *ast.FuncLit, *ast.FuncDecl:
+			return false
+		}
+	}
+	return false
+}
+
+// ensuresOk reports whether `cond` being true implies `key` is Ok or Some.
+func ensuresOk(key string, cond ast.Expr) bool {
+	switch c := cond.(type) {
+	case *ast.ParenExpr:
+		return ensuresOk(key, c.X)
+	case *ast.UnaryExpr:
+		return c.Op == token.NOT && ensuresNotOk(key, c.X)
+	case *ast.BinaryExpr:
+		return c.Op == token.LAND && (ensuresOk(key, c.X) || ensuresOk(key, c.Y))
+	case *ast.CallExpr:
+		return isCheck(key, c, "IsOk", "IsOkAnd", "IsOkAndNotNil", "IsSome", "IsSomeAnd")
+	}
+	return false
+}
+
+// ensuresNotOk reports whether `cond` being false implies `key` is Ok or Some.
+func ensuresNotOk(key string, cond ast.Expr) bool {
+	switch c := cond.(type) {
+	case *ast.ParenExpr:
+		return ensuresNotOk(key, c.X)
+	case *ast.UnaryExpr:
+		return c.Op == token.NOT && ensuresOk(key, c.X)
+	case *ast.BinaryExpr:
+		return c.Op == token.LOR && (ensuresNotOk(key, c.X) || ensuresNotOk(key, c.Y))
+	case *ast.CallExpr:
+		return isCheck(key, c, "IsErr", "IsNone")
+	}
+	return false
+}
+
+func isCheck(key string, c *ast.CallExpr, methods ...string) bool {
+	sel, ok := c.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+	k, ok := exprKey(sel.X)
+	if !ok || k != key {
+		return false
+	}
+	for _, m := range methods {
+		if sel.Sel.Name == m {
+			return true
+		}
+	}
+	return false
+}
+
+// terminates reports whether the block always leaves the enclosing flow:
+// it ends with a return, a branch, a panic or a call to a Fatal, FailNow, Skip or Exit function.
+func terminates(b *ast.BlockStmt) bool {
+	if len(b.List) == 0 {
+		return false
+	}
+	switch s := b.List[len(b.List)-1].(type) {
+	case *ast.ReturnStmt, *ast.BranchStmt:
+		return true
+	case *ast.ExprStmt:
+		c, ok := s.X.(*ast.CallExpr)
+		if !ok {
+			return false
+		}
+		var name string
+		switch f := c.Fun.(type) {
+		case *ast.Ident:
+			name = f.Name
+		case *ast.SelectorExpr:
+			name = f.Sel.Name
+		}
+		return name == "panic" || name == "Exit" || name == "FailNow" || strings.HasPrefix(name, "Fatal") || strings.HasPrefix(name, "Skip")
+	}
+	return false
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"go/types"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+const (
+	resultPath = "github.com/yuanzicheng/go-result-and-option/result"
+	optionPath = "github.com/yuanzicheng/go-result-and-option/option"
+)
+
+// Generate returns the source of a package named `name` wrapping the functions of the package `path`.
+func Generate(path string, funcs []string, name string) ([]byte, error) {
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.NeedName | packages.NeedTypes}, path)
+	if err != nil {
+		return nil, err
+	}
+	if len(pkgs) != 1 || len(pkgs[0].Errors) > 0 {
+		return nil, fmt.Errorf("loading %s: %v", path, pkgs[0].Errors)
+	}
+	pkg := pkgs[0].Types
+	if name == "" {
+		name = pkg.Name() + "r"
+	}
+
+	g := &generator{imports: map[string]string{}}
+	explicit := len(funcs) > 0
+	if !explicit {
+		funcs = pkg.Scope().Names()
+	}
+	for _, fn := range funcs {
+		obj, ok := pkg.Scope().Lookup(fn).(*types.Func)
+		if !ok || !obj.Exported() {
+			if explicit {
+				return nil, fmt.Errorf("%s.%s is not an exported function", path, fn)
+			}
+			continue
+		}
+		if !g.wrap(obj) && explicit {
+			return nil, fmt.Errorf("%s.%s doesn't return (T, error), error or (T, bool)", path, fn)
+		}
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by resultgen -pkg %s. DO NOT EDIT.\n\npackage %s\n\nimport (\n", path, name)
+	paths := make([]string, 0, len(g.imports))
+	for p := range g.imports {
+		paths = append(paths, p)
+	}
+	slices.Sort(paths)
+	for _, p := range paths {
+		fmt.Fprintf(&b, "\t%s %q\n", g.imports[p], p)
+	}
+	b.WriteString(")\n")
+	b.Write(g.body.Bytes())
+	return format.Source(b.Bytes())
+}
+
+type generator struct {
+	imports map[string]string // import path to name
+	body    bytes.Buffer
+}
+
+func (g *generator) qualifier(p *types.Package) string {
+	g.imports[p.Path()] = p.Name()
+	return p.Name()
+}
+
+func (g *generator) typeString(t types.Type) string {
+	return types.TypeString(t, g.qualifier)
+}
+
+var errorType = types.Universe.Lookup("error").Type()
+
+// reserved are the names used by the generated functions, which can't name parameters.
+var reserved = map[string]bool{"v": true, "err": true, "ok": true, "result": true, "option": true}
+
+// wrap writes the wrapper of the function, reporting false if its signature isn't supported.
+func (g *generator) wrap(fn *types.Func) bool {
+	sig := fn.Type().(*types.Signature)
+	if sig.TypeParams().Len() > 0 {
+		return false
+	}
+	res := sig.Results()
+	var kind string
+	switch {
+	case res.Len() == 1 && types.Identical(res.At(0).Type(), errorType):
+		kind = "unit"
+	case res.Len() == 2 && types.Identical(res.At(1).Type(), errorType):
+		kind = "result"
+	case res.Len() == 2 && types.Identical(res.At(1).Type(), types.Typ[types.Bool]):
+		kind = "option"
+	default:
+		return false
+	}
+
+	var params, args []string
+	for i := range sig.Params().Len() {
+		p := sig.Params().At(i)
+		name := p.Name()
+		if name == "" || name == "_" || reserved[name] || name == fn.Pkg().Name() {
+			name = fmt.Sprintf("p%d", i)
+		}
+		typ := g.typeString(p.Type())
+		arg := name
+		if sig.Variadic() && i == sig.Params().Len()-1 {
+			typ = "..." + g.typeString(p.Type().(*types.Slice).Elem())
+			arg += "..."
+		}
+		params = append(params, name+" "+typ)
+		args = append(args, arg)
+	}
+	call := fmt.Sprintf("%s.%s(%s)", g.qualifier(fn.Pkg()), fn.Name(), strings.Join(args, ", "))
+
+	// Values of pointer types are stored as is, e.g. `*os.File` in a `Result[os.File]`.
+	var value types.Type
+	ref := "&v"
+	if res.Len() == 2 {
+		value = res.At(0).Type()
+		if ptr, ok := value.(*types.Pointer); ok {
+			value, ref = ptr.Elem(), "v"
+		}
+	}
+
+	fmt.Fprintf(&g.body, "\n// %s wraps [%s.%s].\n", fn.Name(), fn.Pkg().Name(), fn.Name())
+	switch kind {
+	case "unit":
+		r := g.qualifier(types.NewPackage(resultPath, "result"))
+		fmt.Fprintf(&g.body, "func %s(%s) *%s.Result[struct{}] {\n\treturn %s.New(&struct{}{}, %s)\n}\n",
+			fn.Name(), strings.Join(params, ", "), r, r, call)
+	case "result":
+		r := g.qualifier(types.NewPackage(resultPath, "result"))
+		fmt.Fprintf(&g.body, "func %s(%s) *%s.Result[%s] {\n\tv, err := %s\n\treturn %s.New(%s, err)\n}\n",
+			fn.Name(), strings.Join(params, ", "), r, g.typeString(value), call, r, ref)
+	case "option":
+		o := g.qualifier(types.NewPackage(optionPath, "option"))
+		typ := g.typeString(value)
+		fmt.Fprintf(&g.body, "func %s(%s) *%s.Option[%s] {\n\tv, ok := %s\n\tif !ok {\n\t\treturn %s.None[%s]()\n\t}\n\treturn %s.Some(%s)\n}\n",
+			fn.Name(), strings.Join(params, ", "), o, typ, call, o, typ, o, ref)
+	}
+	return true
+}
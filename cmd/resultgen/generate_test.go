@@ -0,0 +1,40 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	src, err := Generate("os", []string{"Open", "LookupEnv", "Remove", "Chmod"}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := string(src)
+	for _, want := range []string{
+		"package osr",
+		`result "github.com/yuanzicheng/go-result-and-option/result"`,
+		"func Open(name string) *result.Result[os.File] {\n\tv, err := os.Open(name)\n\treturn result.New(v, err)\n}",
+		"func LookupEnv(key string) *option.Option[string] {\n\tv, ok := os.LookupEnv(key)\n\tif !ok {\n\t\treturn option.None[string]()\n\t}\n\treturn option.Some(&v)\n}",
+		"func Remove(name string) *result.Result[struct{}] {\n\treturn result.New(&struct{}{}, os.Remove(name))\n}",
+		"func Chmod(name string, mode os.FileMode) *result.Result[struct{}]",
+	} {
+		if !strings.Contains(s, want) {
+			t.Errorf("Generate is missing %q in:\n%s", want, s)
+		}
+	}
+}
+
+func TestGenerateAll(t *testing.T) {
+	src, err := Generate("strconv", nil, "conv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := string(src)
+	if !strings.Contains(s, "func Atoi(s string) *result.Result[int]") || strings.Contains(s, "func Itoa") {
+		t.Errorf("Generate failed:\n%s", s)
+	}
+	if _, err := Generate("strconv", []string{"Itoa"}, ""); err == nil {
+		t.Error("Generate accepted an unsupported function")
+	}
+}
@@ -0,0 +1,69 @@
+// Command resultgen generates a package wrapping the functions of another package
+// into variants returning results and options: a `func(...) (T, error)` becomes a
+// `func(...) *result.Result[T]`, a `func(...) error` a `func(...) *result.Result[struct{}]`
+// and a `func(...) (T, bool)` a `func(...) *option.Option[T]`. Pointer values are
+// stored as is, e.g. `(*os.File, error)` becomes `*result.Result[os.File]`.
+//
+// Usage:
+//
+//	resultgen -pkg os -funcs Open,ReadFile,LookupEnv -name osr -o osr/osr.go
+//
+// Without -funcs, all the exported functions with a matching signature are wrapped.
+// Generic functions are skipped.
+//
+// With -mono, resultgen instead generates non-generic option and result types
+// specialized for the given types, holding their values inline, e.g.
+//
+//	resultgen -mono int64,string,Dur=time.Duration -name fast -o fast/fast.go
+//
+// generates OptionInt64, ResultInt64, OptionString, ResultString, OptionDur and ResultDur,
+// with conversions from and to their generic counterparts. Types of other packages are given
+// with their import path, e.g. `net/netip.Addr`.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+)
+
+func main() {
+	pkg := flag.String("pkg", "", "import path of the package to wrap")
+	funcs := flag.String("funcs", "", "comma-separated functions to wrap, all by default")
+	name := flag.String("name", "", "name of the generated package, the wrapped package name suffixed with r or mono by default")
+	mono := flag.String("mono", "", "comma-separated types to generate specialized options and results for")
+	out := flag.String("o", "", "output file, standard output by default")
+	flag.Parse()
+
+	var src []byte
+	var err error
+	switch {
+	case *mono != "":
+		if *name == "" {
+			*name = "mono"
+		}
+		src, err = GenerateMono(strings.Split(*mono, ","), *name)
+	case *pkg != "":
+		var names []string
+		if *funcs != "" {
+			names = strings.Split(*funcs, ",")
+		}
+		src, err = Generate(*pkg, names, *name)
+	default:
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "resultgen:", err)
+		os.Exit(1)
+	}
+	if *out == "" {
+		os.Stdout.Write(src)
+		return
+	}
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		fmt.Fprintln(os.Stderr, "resultgen:", err)
+		os.Exit(1)
+	}
+}
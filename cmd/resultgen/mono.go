@@ -0,0 +1,239 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"path"
+	"slices"
+	"strings"
+	"text/template"
+	"unicode"
+)
+
+// monoType is a type specialized by GenerateMono.
+type monoType struct {
+	// Name suffixes the generated names, e.g. `Int64` in `OptionInt64`.
+	Name string
+	// Type is the Go type, e.g. `int64` or `time.Duration`.
+	Type       string
+	importPath string
+}
+
+// parseMonoType parses a type given as `type`, `name=type` or `import/path.Type`.
+// Only named types can be given without name.
+func parseMonoType(s string) (monoType, error) {
+	var t monoType
+	name, typ, ok := strings.Cut(s, "=")
+	if !ok {
+		name, typ = "", s
+	}
+	if i := strings.LastIndex(typ, "."); i >= 0 {
+		t.importPath = strings.TrimLeft(typ[:i], "[]*")
+		typ = typ[:len(typ)-len(strings.TrimLeft(typ, "[]*"))] + path.Base(t.importPath) + typ[i:]
+	}
+	if name == "" {
+		_, base, _ := strings.Cut(typ, ".")
+		if base == "" {
+			base = typ
+		}
+		// Composite types must be named, e.g. `Bytes=[]byte`.
+		if isIdent(base) {
+			r := []rune(base)
+			r[0] = unicode.ToUpper(r[0])
+			name = string(r)
+		}
+	}
+	if !isIdent(name) {
+		return t, fmt.Errorf("invalid name %q for type %q, use name=type", name, typ)
+	}
+	t.Name, t.Type = name, typ
+	return t, nil
+}
+
+func isIdent(s string) bool {
+	for i, r := range s {
+		if !unicode.IsLetter(r) && r != '_' && (i == 0 || !unicode.IsDigit(r)) {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// GenerateMono returns the source of a package named `name` with non-generic
+// option and result types specialized for the types.
+func GenerateMono(types []string, name string) ([]byte, error) {
+	imports := []string{optionPath, resultPath}
+	var ts []monoType
+	for _, s := range types {
+		t, err := parseMonoType(s)
+		if err != nil {
+			return nil, err
+		}
+		if t.importPath != "" && !slices.Contains(imports, t.importPath) {
+			imports = append(imports, t.importPath)
+		}
+		ts = append(ts, t)
+	}
+	slices.Sort(imports)
+
+	var b bytes.Buffer
+	err := monoTemplate.Execute(&b, map[string]any{
+		"Args":    strings.Join(types, ","),
+		"Package": name,
+		"Imports": imports,
+		"Types":   ts,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return format.Source(b.Bytes())
+}
+
+var monoTemplate = template.Must(template.New("mono").Parse(`// Code generated by resultgen -mono {{.Args}}. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+{{- range .Imports}}
+	{{printf "%q" .}}
+{{- end}}
+)
+{{range .Types}}
+// Option{{.Name}} is an option.Option[{{.Type}}] holding its value inline, without allocation.
+type Option{{.Name}} struct {
+	value {{.Type}}
+	ok    bool
+}
+
+// Some{{.Name}} returns a [` + "`Some`" + `] of the value.
+func Some{{.Name}}(v {{.Type}}) Option{{.Name}} {
+	return Option{{.Name}}{value: v, ok: true}
+}
+
+// None{{.Name}} returns a [` + "`None`" + `].
+func None{{.Name}}() Option{{.Name}} {
+	return Option{{.Name}}{}
+}
+
+// Option{{.Name}}Of converts an option, copying its value.
+func Option{{.Name}}Of(o *option.Option[{{.Type}}]) Option{{.Name}} {
+	if o.IsNone() {
+		return Option{{.Name}}{}
+	}
+	return Option{{.Name}}{value: *o.Unwrap(""), ok: true}
+}
+
+// IsSome returns ` + "`true`" + ` if the option is a [` + "`Some`" + `].
+func (o Option{{.Name}}) IsSome() bool {
+	return o.ok
+}
+
+// IsNone returns ` + "`true`" + ` if the option is a [` + "`None`" + `].
+func (o Option{{.Name}}) IsNone() bool {
+	return !o.ok
+}
+
+// Get returns the value and whether the option is a [` + "`Some`" + `].
+func (o Option{{.Name}}) Get() ({{.Type}}, bool) {
+	return o.value, o.ok
+}
+
+// Unwrap returns the contained [` + "`Some`" + `] value. Panics if the option is a [` + "`None`" + `].
+func (o Option{{.Name}}) Unwrap() {{.Type}} {
+	if !o.ok {
+		panic("called ` + "`Option::unwrap()`" + ` on a ` + "`None`" + ` value")
+	}
+	return o.value
+}
+
+// UnwrapOr returns the contained [` + "`Some`" + `] value or ` + "`v`" + `.
+func (o Option{{.Name}}) UnwrapOr(v {{.Type}}) {{.Type}} {
+	if !o.ok {
+		return v
+	}
+	return o.value
+}
+
+// Option converts the option to an option.Option[{{.Type}}].
+func (o Option{{.Name}}) Option() *option.Option[{{.Type}}] {
+	if !o.ok {
+		return option.None[{{.Type}}]()
+	}
+	v := o.value
+	return option.Some(&v)
+}
+
+// Result{{.Name}} is a result.Result[{{.Type}}] holding its value inline, without allocation.
+type Result{{.Name}} struct {
+	value {{.Type}}
+	err   error
+}
+
+// Ok{{.Name}} returns an [` + "`Ok`" + `] of the value.
+func Ok{{.Name}}(v {{.Type}}) Result{{.Name}} {
+	return Result{{.Name}}{value: v}
+}
+
+// Err{{.Name}} returns an [` + "`Err`" + `] of the error.
+func Err{{.Name}}(err error) Result{{.Name}} {
+	return Result{{.Name}}{err: err}
+}
+
+// Result{{.Name}}Of converts a result, copying its value. A nil [` + "`Ok`" + `] value becomes the zero value.
+func Result{{.Name}}Of(r *result.Result[{{.Type}}]) Result{{.Name}} {
+	if r.IsErr() {
+		return Result{{.Name}}{err: r.UnwrapError()}
+	}
+	var v {{.Type}}
+	if p := r.Unwrap(); p != nil {
+		v = *p
+	}
+	return Result{{.Name}}{value: v}
+}
+
+// IsOk returns ` + "`true`" + ` if the result is [` + "`Ok`" + `].
+func (r Result{{.Name}}) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr returns ` + "`true`" + ` if the result is [` + "`Err`" + `].
+func (r Result{{.Name}}) IsErr() bool {
+	return r.err != nil
+}
+
+// Get returns the value and the error of the result.
+func (r Result{{.Name}}) Get() ({{.Type}}, error) {
+	return r.value, r.err
+}
+
+// Err returns the error of the result, nil for an [` + "`Ok`" + `].
+func (r Result{{.Name}}) Err() error {
+	return r.err
+}
+
+// Unwrap returns the contained [` + "`Ok`" + `] value. Panics if the result is an [` + "`Err`" + `].
+func (r Result{{.Name}}) Unwrap() {{.Type}} {
+	if r.err != nil {
+		panic("called ` + "`Result::unwrap()`" + ` on an ` + "`Err`" + ` value")
+	}
+	return r.value
+}
+
+// UnwrapOr returns the contained [` + "`Ok`" + `] value or ` + "`v`" + `.
+func (r Result{{.Name}}) UnwrapOr(v {{.Type}}) {{.Type}} {
+	if r.err != nil {
+		return v
+	}
+	return r.value
+}
+
+// Result converts the result to a result.Result[{{.Type}}].
+func (r Result{{.Name}}) Result() *result.Result[{{.Type}}] {
+	if r.err != nil {
+		return result.Err[{{.Type}}](r.err)
+	}
+	v := r.value
+	return result.Ok(&v)
+}
+{{end}}`))
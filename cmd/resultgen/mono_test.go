@@ -0,0 +1,68 @@
+package main
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestParseMonoType(t *testing.T) {
+	for s, want := range map[string]monoType{
+		"int64":                        {Name: "Int64", Type: "int64"},
+		"Dur=time.Duration":            {Name: "Dur", Type: "time.Duration", importPath: "time"},
+		"net/netip.Addr":               {Name: "Addr", Type: "netip.Addr", importPath: "net/netip"},
+		"Prefixes=[]*net/netip.Prefix": {Name: "Prefixes", Type: "[]*netip.Prefix", importPath: "net/netip"},
+	} {
+		if got, err := parseMonoType(s); err != nil || got != want {
+			t.Errorf("parseMonoType(%q) = %+v, %v", s, got, err)
+		}
+	}
+	if _, err := parseMonoType("[]byte"); err == nil {
+		t.Error("parseMonoType accepted an unnamed slice type")
+	}
+}
+
+func TestGenerateMono(t *testing.T) {
+	src, err := GenerateMono([]string{"int64", "Dur=time.Duration"}, "fast")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := string(src)
+	for _, want := range []string{"package fast", `"time"`, "type OptionInt64 struct", "func OkDur(v time.Duration) ResultDur"} {
+		if !strings.Contains(s, want) {
+			t.Errorf("GenerateMono is missing %q in:\n%s", want, s)
+		}
+	}
+	if testing.Short() {
+		return
+	}
+
+	// Build the generated package against the library.
+	root, _ := filepath.Abs("../..")
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module fast\n\ngo 1.23\n\nrequire github.com/yuanzicheng/go-result-and-option v0.0.0\n\nreplace github.com/yuanzicheng/go-result-and-option => "+root+"\n"), 0o644)
+	os.WriteFile(filepath.Join(dir, "fast.go"), src, 0o644)
+	os.WriteFile(filepath.Join(dir, "fast_test.go"), []byte(`package fast
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFast(t *testing.T) {
+	if SomeInt64(3).Option().UnwrapOr(nil) == nil || OptionInt64Of(NoneInt64().Option()).IsSome() {
+		t.Error("OptionInt64 failed")
+	}
+	if ResultDurOf(ErrDur(errors.New("boom")).Result()).IsOk() || OkDur(2).Result().IsErr() || OkInt64(1).UnwrapOr(2) != 1 {
+		t.Error("ResultDur failed")
+	}
+}
+`), 0o644)
+	cmd := exec.Command("go", "test", "./...")
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Errorf("generated package doesn't work: %v\n%s", err, out)
+	}
+}
@@ -0,0 +1,175 @@
+// Package codec encodes options and results in a self-describing, versioned envelope,
+// so that stored events containing them can be decoded by later versions of the library.
+//
+// An envelope is made of:
+//   - the magic bytes "RO";
+//   - the format version of the writer, and the minimum format version a reader must support;
+//   - a length-prefixed header holding the kind of the value and the name of its type;
+//   - the length-prefixed payload, the binary encoding of the option or the result.
+//
+// Readers skip the header fields and the trailing bytes they don't know of, so writers can
+// add them without breaking older readers; incompatible changes raise the minimum version.
+package codec
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// Version is the format version written and read by this package.
+const Version = 1
+
+// minVersion is the minimum format version of the readers of the envelopes written by this package.
+const minVersion = 1
+
+var magic = [2]byte{'R', 'O'}
+
+// Kind is the kind of the value of an envelope.
+type Kind uint8
+
+const (
+	Option Kind = iota + 1
+	Result
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Option:
+		return "option"
+	case Result:
+		return "result"
+	}
+	return fmt.Sprintf("kind(%d)", uint8(k))
+}
+
+// Envelope is a decoded envelope.
+type Envelope struct {
+	// Version is the format version of the writer.
+	Version uint8
+	Kind    Kind
+	// Type is the name of the type of the value, e.g. `int` for an `Option[int]`.
+	Type    string
+	Payload []byte
+}
+
+var (
+	// ErrFormat is the error of malformed envelopes.
+	ErrFormat = errors.New("codec: malformed envelope")
+	// ErrVersion is the error of envelopes requiring a later format version.
+	ErrVersion = errors.New("codec: unsupported format version")
+	// ErrType is the error of envelopes holding another kind or type of value than expected.
+	ErrType = errors.New("codec: type mismatch")
+)
+
+// seal returns the envelope of the payload.
+func seal(kind Kind, typ string, payload []byte) []byte {
+	header := binary.AppendUvarint([]byte{byte(kind)}, uint64(len(typ)))
+	header = append(header, typ...)
+	b := append(magic[:], Version, minVersion)
+	b = binary.AppendUvarint(b, uint64(len(header)))
+	b = append(b, header...)
+	b = binary.AppendUvarint(b, uint64(len(payload)))
+	return append(b, payload...)
+}
+
+// Open decodes an envelope without decoding its payload. The errors are tagged with `errkind.Invalid`.
+func Open(data []byte) *result.Result[Envelope] {
+	env, err := open(data)
+	if err != nil {
+		return result.Err[Envelope](errkind.Wrap(errkind.Invalid, err))
+	}
+	return result.Ok(env)
+}
+
+func open(data []byte) (*Envelope, error) {
+	if len(data) < 4 || [2]byte(data[:2]) != magic {
+		return nil, ErrFormat
+	}
+	env := &Envelope{Version: data[2]}
+	if data[3] > Version {
+		return nil, fmt.Errorf("%w: %d", ErrVersion, data[3])
+	}
+	header, rest, ok := chunk(data[4:])
+	if !ok || len(header) == 0 {
+		return nil, ErrFormat
+	}
+	env.Kind = Kind(header[0])
+	typ, _, ok := chunk(header[1:])
+	if !ok {
+		return nil, ErrFormat
+	}
+	env.Type = string(typ)
+	if env.Payload, _, ok = chunk(rest); !ok {
+		return nil, ErrFormat
+	}
+	return env, nil
+}
+
+// chunk splits a length-prefixed chunk from the data.
+func chunk(data []byte) ([]byte, []byte, bool) {
+	n, k := binary.Uvarint(data)
+	if k <= 0 || uint64(len(data)-k) < n {
+		return nil, nil, false
+	}
+	return data[k : k+int(n)], data[k+int(n):], true
+}
+
+// EncodeOption returns the envelope of the option, see `option.Option.MarshalBinary`.
+func EncodeOption[T any](o *option.Option[T]) *result.Result[[]byte] {
+	payload, err := o.MarshalBinary()
+	if err != nil {
+		return result.Err[[]byte](err)
+	}
+	b := seal(Option, typeName[T](), payload)
+	return result.Ok(&b)
+}
+
+// DecodeOption decodes the envelope of an `Option[T]`. The errors are tagged with `errkind.Invalid`.
+func DecodeOption[T any](data []byte) *result.Result[option.Option[T]] {
+	var o option.Option[T]
+	if err := decode(data, Option, typeName[T](), &o); err != nil {
+		return result.Err[option.Option[T]](errkind.Wrap(errkind.Invalid, err))
+	}
+	return result.Ok(&o)
+}
+
+// EncodeResult returns the envelope of the result, see `result.Result.MarshalBinary`.
+func EncodeResult[T any](r *result.Result[T]) *result.Result[[]byte] {
+	payload, err := r.MarshalBinary()
+	if err != nil {
+		return result.Err[[]byte](err)
+	}
+	b := seal(Result, typeName[T](), payload)
+	return result.Ok(&b)
+}
+
+// DecodeResult decodes the envelope of a `Result[T]`, the error of an [`Err`] being decoded
+// as an error with the same message. The errors are tagged with `errkind.Invalid`.
+func DecodeResult[T any](data []byte) *result.Result[result.Result[T]] {
+	var r result.Result[T]
+	if err := decode(data, Result, typeName[T](), &r); err != nil {
+		return result.Err[result.Result[T]](errkind.Wrap(errkind.Invalid, err))
+	}
+	return result.Ok(&r)
+}
+
+func decode(data []byte, kind Kind, typ string, v interface{ UnmarshalBinary([]byte) error }) error {
+	env, err := open(data)
+	if err != nil {
+		return err
+	}
+	if env.Kind != kind || env.Type != typ {
+		return fmt.Errorf("%w: %s of %s, want %s of %s", ErrType, env.Kind, env.Type, kind, typ)
+	}
+	return v.UnmarshalBinary(env.Payload)
+}
+
+func typeName[T any]() string {
+	return reflect.TypeFor[T]().String()
+}
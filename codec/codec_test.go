@@ -0,0 +1,63 @@
+package codec
+
+import (
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+func TestOption(t *testing.T) {
+	x := int64(42)
+	b := EncodeOption(option.Some(&x)).Unwrap()
+	if o := DecodeOption[int64](*b).Unwrap(); *o.Unwrap("") != 42 {
+		t.Error("DecodeOption failed")
+	}
+	env := Open(*b).Unwrap()
+	if env.Version != Version || env.Kind != Option || env.Type != "int64" {
+		t.Errorf("Open failed: %+v", env)
+	}
+	if err := DecodeOption[int32](*b).UnwrapError(); !errors.Is(err, ErrType) || !errors.Is(err, errkind.Invalid) {
+		t.Errorf("DecodeOption failed to reject another type: %v", err)
+	}
+	if !errors.Is(DecodeResult[int64](*b).UnwrapError(), ErrType) {
+		t.Error("DecodeResult failed to reject an option")
+	}
+}
+
+func TestResult(t *testing.T) {
+	b := EncodeResult(result.Err[int64](errors.New("boom"))).Unwrap()
+	if r := DecodeResult[int64](*b).Unwrap(); r.UnwrapError().Error() != "boom" {
+		t.Error("DecodeResult failed")
+	}
+}
+
+func TestForwardCompatible(t *testing.T) {
+	// A later writer adding a header field and trailing data, readable by version 1 readers.
+	header := append([]byte{byte(Option), 5}, "int64"...)
+	header = append(header, 0xff, 0xff)
+	payload, _ := option.None[int64]().MarshalBinary()
+	b := binary.AppendUvarint([]byte{'R', 'O', 2, 1}, uint64(len(header)))
+	b = append(b, header...)
+	b = binary.AppendUvarint(b, uint64(len(payload)))
+	b = append(append(b, payload...), "extension"...)
+	if r := DecodeOption[int64](b); !r.IsOk() || r.Unwrap().IsSome() {
+		t.Errorf("DecodeOption failed on a later version: %v", r)
+	}
+	if env := Open(b).Unwrap(); env.Version != 2 {
+		t.Error("Open failed on a later version")
+	}
+
+	b[3] = 2
+	if !errors.Is(DecodeOption[int64](b).UnwrapError(), ErrVersion) {
+		t.Error("DecodeOption failed to reject an incompatible version")
+	}
+	for _, data := range [][]byte{nil, []byte("RO"), []byte("XX\x01\x01\x00"), []byte("RO\x01\x01\x09")} {
+		if !errors.Is(Open(data).UnwrapError(), ErrFormat) {
+			t.Errorf("Open failed to reject %q", data)
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package collections
+
+import "github.com/yuanzicheng/go-result-and-option/option"
+
+// Deque is a double-ended queue backed by a growable ring buffer.
+// The zero value is an empty deque ready for use.
+type Deque[T any] struct {
+	buf   []T
+	head  int
+	count int
+}
+
+// Len returns the number of elements.
+func (d *Deque[T]) Len() int {
+	return d.count
+}
+
+// PushBack appends an element to the back.
+func (d *Deque[T]) PushBack(v T) {
+	d.grow()
+	d.buf[(d.head+d.count)%len(d.buf)] = v
+	d.count++
+}
+
+// PushFront prepends an element to the front.
+func (d *Deque[T]) PushFront(v T) {
+	d.grow()
+	d.head = (d.head - 1 + len(d.buf)) % len(d.buf)
+	d.buf[d.head] = v
+	d.count++
+}
+
+// PopFront removes and returns the front element, or [`None`] if the deque is empty.
+func (d *Deque[T]) PopFront() *option.Option[T] {
+	if d.count == 0 {
+		return option.None[T]()
+	}
+	v := d.buf[d.head]
+	var zero T
+	d.buf[d.head] = zero
+	d.head = (d.head + 1) % len(d.buf)
+	d.count--
+	return option.Some(&v)
+}
+
+// PopBack removes and returns the back element, or [`None`] if the deque is empty.
+func (d *Deque[T]) PopBack() *option.Option[T] {
+	if d.count == 0 {
+		return option.None[T]()
+	}
+	i := (d.head + d.count - 1) % len(d.buf)
+	v := d.buf[i]
+	var zero T
+	d.buf[i] = zero
+	d.count--
+	return option.Some(&v)
+}
+
+// Front returns the front element, or [`None`] if the deque is empty.
+func (d *Deque[T]) Front() *option.Option[T] {
+	return d.At(0)
+}
+
+// Back returns the back element, or [`None`] if the deque is empty.
+func (d *Deque[T]) Back() *option.Option[T] {
+	return d.At(d.count - 1)
+}
+
+// At returns the element at index `i` from the front, or [`None`] if `i` is out of range.
+func (d *Deque[T]) At(i int) *option.Option[T] {
+	if i < 0 || i >= d.count {
+		return option.None[T]()
+	}
+	v := d.buf[(d.head+i)%len(d.buf)]
+	return option.Some(&v)
+}
+
+func (d *Deque[T]) grow() {
+	if d.count < len(d.buf) {
+		return
+	}
+	buf := make([]T, max(2*len(d.buf), 8))
+	for i := range d.count {
+		buf[i] = d.buf[(d.head+i)%len(d.buf)]
+	}
+	d.buf = buf
+	d.head = 0
+}
@@ -0,0 +1,20 @@
+package collections
+
+import "testing"
+
+func TestDeque(t *testing.T) {
+	var d Deque[int]
+	if d.PopFront().IsSome() || d.PopBack().IsSome() || d.Front().IsSome() {
+		t.Error("Deque failed on empty")
+	}
+	for i := range 10 {
+		d.PushBack(i)
+		d.PushFront(-i)
+	}
+	if d.Len() != 20 || *d.Front().Unwrap("") != -9 || *d.Back().Unwrap("") != 9 {
+		t.Error("Push failed")
+	}
+	if *d.PopFront().Unwrap("") != -9 || *d.PopBack().Unwrap("") != 9 || d.Len() != 18 {
+		t.Error("Pop failed")
+	}
+}
@@ -0,0 +1,108 @@
+package collections
+
+import "github.com/yuanzicheng/go-result-and-option/option"
+
+// Element is an element of a List.
+type Element[T any] struct {
+	Value T
+
+	next, prev *Element[T]
+	list       *List[T]
+}
+
+// Next returns the next element, or [`None`] at the back of the list.
+func (e *Element[T]) Next() *option.Option[Element[T]] {
+	if e.list == nil || e.next == &e.list.root {
+		return option.None[Element[T]]()
+	}
+	return option.Some(e.next)
+}
+
+// Prev returns the previous element, or [`None`] at the front of the list.
+func (e *Element[T]) Prev() *option.Option[Element[T]] {
+	if e.list == nil || e.prev == &e.list.root {
+		return option.None[Element[T]]()
+	}
+	return option.Some(e.prev)
+}
+
+// List is a doubly linked list.
+// The zero value is an empty list ready for use.
+type List[T any] struct {
+	root Element[T]
+	len  int
+}
+
+func (l *List[T]) lazyInit() {
+	if l.root.next == nil {
+		l.root.next = &l.root
+		l.root.prev = &l.root
+	}
+}
+
+// Len returns the number of elements.
+func (l *List[T]) Len() int {
+	return l.len
+}
+
+// Front returns the first element, or [`None`] if the list is empty.
+func (l *List[T]) Front() *option.Option[Element[T]] {
+	if l.len == 0 {
+		return option.None[Element[T]]()
+	}
+	return option.Some(l.root.next)
+}
+
+// Back returns the last element, or [`None`] if the list is empty.
+func (l *List[T]) Back() *option.Option[Element[T]] {
+	if l.len == 0 {
+		return option.None[Element[T]]()
+	}
+	return option.Some(l.root.prev)
+}
+
+// PushFront inserts a value at the front of the list and returns its element.
+func (l *List[T]) PushFront(v T) *Element[T] {
+	l.lazyInit()
+	return l.insert(&Element[T]{Value: v}, &l.root)
+}
+
+// PushBack inserts a value at the back of the list and returns its element.
+func (l *List[T]) PushBack(v T) *Element[T] {
+	l.lazyInit()
+	return l.insert(&Element[T]{Value: v}, l.root.prev)
+}
+
+// Remove removes the element from the list if it belongs to it, returning its value.
+func (l *List[T]) Remove(e *Element[T]) T {
+	if e.list == l {
+		e.prev.next = e.next
+		e.next.prev = e.prev
+		e.next, e.prev, e.list = nil, nil, nil
+		l.len--
+	}
+	return e.Value
+}
+
+// RemoveIf removes the elements satisfying the predicate, returning their values in order.
+func (l *List[T]) RemoveIf(pred func(T) bool) []T {
+	var removed []T
+	for e := l.root.next; e != nil && e != &l.root; {
+		next := e.next
+		if pred(e.Value) {
+			removed = append(removed, l.Remove(e))
+		}
+		e = next
+	}
+	return removed
+}
+
+func (l *List[T]) insert(e, at *Element[T]) *Element[T] {
+	e.prev = at
+	e.next = at.next
+	e.prev.next = e
+	e.next.prev = e
+	e.list = l
+	l.len++
+	return e
+}
@@ -0,0 +1,36 @@
+package collections
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestList(t *testing.T) {
+	var l List[int]
+	if l.Front().IsSome() || l.Back().IsSome() {
+		t.Error("List failed on empty")
+	}
+	for i := range 5 {
+		l.PushBack(i)
+	}
+	l.PushFront(-1)
+
+	var values []int
+	for e := l.Front(); e.IsSome(); e = e.Unwrap("").Next() {
+		values = append(values, e.Unwrap("").Value)
+	}
+	if !slices.Equal(values, []int{-1, 0, 1, 2, 3, 4}) {
+		t.Errorf("Next failed: %v", values)
+	}
+	if l.Back().Unwrap("").Prev().Unwrap("").Value != 3 {
+		t.Error("Prev failed")
+	}
+
+	removed := l.RemoveIf(func(v int) bool { return v%2 == 0 })
+	if !slices.Equal(removed, []int{0, 2, 4}) || l.Len() != 3 {
+		t.Errorf("RemoveIf failed: %v", removed)
+	}
+	if l.Front().Unwrap("").Prev().IsSome() || l.Back().Unwrap("").Next().IsSome() {
+		t.Error("List failed at the ends")
+	}
+}
@@ -0,0 +1,90 @@
+package collections
+
+import (
+	"cmp"
+	"container/heap"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+)
+
+// Item is an element of a PriorityQueue, used to update its priority.
+type Item[T any, P cmp.Ordered] struct {
+	Value    T
+	priority P
+	index    int
+}
+
+// Priority returns the priority of the item.
+func (it *Item[T, P]) Priority() P {
+	return it.priority
+}
+
+type items[T any, P cmp.Ordered] []*Item[T, P]
+
+func (h items[T, P]) Len() int           { return len(h) }
+func (h items[T, P]) Less(i, j int) bool { return h[i].priority < h[j].priority }
+func (h items[T, P]) Swap(i, j int) {
+	h[i], h[j] = h[j], h[i]
+	h[i].index = i
+	h[j].index = j
+}
+func (h *items[T, P]) Push(x any) {
+	it := x.(*Item[T, P])
+	it.index = len(*h)
+	*h = append(*h, it)
+}
+func (h *items[T, P]) Pop() any {
+	old := *h
+	it := old[len(old)-1]
+	old[len(old)-1] = nil
+	it.index = -1
+	*h = old[:len(old)-1]
+	return it
+}
+
+// PriorityQueue is a binary heap popping the element with the smallest priority first.
+// The zero value is an empty queue ready for use.
+type PriorityQueue[T any, P cmp.Ordered] struct {
+	h items[T, P]
+}
+
+// Len returns the number of elements.
+func (q *PriorityQueue[T, P]) Len() int {
+	return len(q.h)
+}
+
+// Push adds an element with the given priority, returning its item.
+func (q *PriorityQueue[T, P]) Push(v T, priority P) *Item[T, P] {
+	it := &Item[T, P]{Value: v, priority: priority}
+	heap.Push(&q.h, it)
+	return it
+}
+
+// Peek returns the element with the smallest priority, or [`None`] if the queue is empty.
+func (q *PriorityQueue[T, P]) Peek() *option.Option[T] {
+	if len(q.h) == 0 {
+		return option.None[T]()
+	}
+	v := q.h[0].Value
+	return option.Some(&v)
+}
+
+// Pop removes and returns the element with the smallest priority, or [`None`] if the queue is empty.
+func (q *PriorityQueue[T, P]) Pop() *option.Option[T] {
+	if len(q.h) == 0 {
+		return option.None[T]()
+	}
+	it := heap.Pop(&q.h).(*Item[T, P])
+	return option.Some(&it.Value)
+}
+
+// UpdatePriority changes the priority of an item still in the queue.
+// Returns `false` if the item has already been popped.
+func (q *PriorityQueue[T, P]) UpdatePriority(it *Item[T, P], priority P) bool {
+	if it.index < 0 || it.index >= len(q.h) || q.h[it.index] != it {
+		return false
+	}
+	it.priority = priority
+	heap.Fix(&q.h, it.index)
+	return true
+}
@@ -0,0 +1,25 @@
+package collections
+
+import "testing"
+
+func TestPriorityQueue(t *testing.T) {
+	var q PriorityQueue[string, int]
+	if q.Peek().IsSome() || q.Pop().IsSome() {
+		t.Error("PriorityQueue failed on empty")
+	}
+	q.Push("b", 2)
+	c := q.Push("c", 3)
+	q.Push("a", 1)
+	if *q.Peek().Unwrap("") != "a" {
+		t.Error("Peek failed")
+	}
+	if !q.UpdatePriority(c, 0) || *q.Pop().Unwrap("") != "c" {
+		t.Error("UpdatePriority failed")
+	}
+	if q.UpdatePriority(c, 5) {
+		t.Error("UpdatePriority failed on popped item")
+	}
+	if *q.Pop().Unwrap("") != "a" || *q.Pop().Unwrap("") != "b" || q.Len() != 0 {
+		t.Error("Pop failed")
+	}
+}
@@ -0,0 +1,49 @@
+package collections
+
+import "github.com/yuanzicheng/go-result-and-option/option"
+
+// Stack is a last-in-first-out stack.
+// The zero value is an empty stack ready for use.
+type Stack[T any] struct {
+	s []T
+}
+
+// Len returns the number of elements.
+func (s *Stack[T]) Len() int {
+	return len(s.s)
+}
+
+// Push pushes an element on top of the stack.
+func (s *Stack[T]) Push(v T) {
+	s.s = append(s.s, v)
+}
+
+// Top returns the element on top of the stack, or [`None`] if the stack is empty.
+func (s *Stack[T]) Top() *option.Option[T] {
+	if len(s.s) == 0 {
+		return option.None[T]()
+	}
+	v := s.s[len(s.s)-1]
+	return option.Some(&v)
+}
+
+// Pop removes and returns the element on top of the stack, or [`None`] if the stack is empty.
+func (s *Stack[T]) Pop() *option.Option[T] {
+	if len(s.s) == 0 {
+		return option.None[T]()
+	}
+	v := s.s[len(s.s)-1]
+	var zero T
+	s.s[len(s.s)-1] = zero
+	s.s = s.s[:len(s.s)-1]
+	return option.Some(&v)
+}
+
+// PopIf removes and returns the element on top of the stack only if it satisfies
+// the predicate, otherwise returns [`None`] leaving the stack untouched.
+func (s *Stack[T]) PopIf(pred func(T) bool) *option.Option[T] {
+	if len(s.s) == 0 || !pred(s.s[len(s.s)-1]) {
+		return option.None[T]()
+	}
+	return s.Pop()
+}
@@ -0,0 +1,21 @@
+package collections
+
+import "testing"
+
+func TestStack(t *testing.T) {
+	var s Stack[rune]
+	if s.Pop().IsSome() || s.Top().IsSome() {
+		t.Error("Stack failed on empty")
+	}
+	s.Push('(')
+	s.Push('[')
+	if s.PopIf(func(r rune) bool { return r == '(' }).IsSome() {
+		t.Error("PopIf failed")
+	}
+	if *s.PopIf(func(r rune) bool { return r == '[' }).Unwrap("") != '[' {
+		t.Error("PopIf failed")
+	}
+	if *s.Top().Unwrap("") != '(' || *s.Pop().Unwrap("") != '(' || s.Len() != 0 {
+		t.Error("Pop failed")
+	}
+}
@@ -0,0 +1,118 @@
+package configx
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/osx"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// Source is a source of configuration values.
+type Source interface {
+	// Get returns the value of the key, or [`None`] if the source doesn't define it.
+	Get(key string) *option.Option[string]
+}
+
+// SourceFunc is a function implementing Source.
+type SourceFunc func(key string) *option.Option[string]
+
+func (f SourceFunc) Get(key string) *option.Option[string] {
+	return f(key)
+}
+
+// Chain returns a source layering the sources, the value of a key coming
+// from the first source defining it.
+func Chain(sources ...Source) Source {
+	return SourceFunc(func(key string) *option.Option[string] {
+		for _, s := range sources {
+			if v := s.Get(key); v.IsSome() {
+				return v
+			}
+		}
+		return option.None[string]()
+	})
+}
+
+// Map returns a source reading the values from a map.
+func Map(m map[string]string) Source {
+	return SourceFunc(func(key string) *option.Option[string] {
+		v, ok := m[key]
+		if !ok {
+			return option.None[string]()
+		}
+		return option.Some(&v)
+	})
+}
+
+// Env returns a source reading the values from environment variables, named
+// by upper-casing the key, replacing dots and dashes with underscores and
+// prepending the prefix, e.g. `db.max-conns` is read from `APP_DB_MAX_CONNS` with an `APP_` prefix.
+func Env(prefix string) Source {
+	r := strings.NewReplacer(".", "_", "-", "_")
+	return SourceFunc(func(key string) *option.Option[string] {
+		return osx.Getenv(prefix + strings.ToUpper(r.Replace(key)))
+	})
+}
+
+// File returns a source reading the values from a file of `key=value` lines.
+// Blank lines and lines starting with `#` are ignored, and keys and values are trimmed.
+// Malformed lines are errors tagged with `errkind.Invalid`.
+func File(path string) *result.Result[Source] {
+	return result.AndThen(osx.Open(path), func(f *os.File) *result.Result[Source] {
+		defer f.Close()
+		m := make(map[string]string)
+		sc := bufio.NewScanner(f)
+		for line := 1; sc.Scan(); line++ {
+			s := strings.TrimSpace(sc.Text())
+			if s == "" || strings.HasPrefix(s, "#") {
+				continue
+			}
+			k, v, ok := strings.Cut(s, "=")
+			if !ok {
+				return result.Err[Source](errkind.Wrap(errkind.Invalid, fmt.Errorf("configx: %s:%d: missing '='", path, line)))
+			}
+			m[strings.TrimSpace(k)] = strings.TrimSpace(v)
+		}
+		if err := sc.Err(); err != nil {
+			return result.Err[Source](err)
+		}
+		src := Map(m)
+		return result.Ok(&src)
+	})
+}
+
+// Get parses the value of the key with `parse`. Returns [`Ok`] of [`None`] if the key
+// is undefined, or an [`Err`] naming the key tagged with `errkind.Invalid` if the value can't be parsed.
+func Get[T any](s Source, key string, parse func(string) (T, error)) *result.Result[option.Option[T]] {
+	v := s.Get(key)
+	if v.IsNone() {
+		return result.Ok(option.None[T]())
+	}
+	x, err := parse(*v.Unwrap(""))
+	if err != nil {
+		return result.Err[option.Option[T]](errkind.Wrap(errkind.Invalid, fmt.Errorf("configx: %s: %w", key, err)))
+	}
+	return result.Ok(option.Some(&x))
+}
+
+// GetInt returns the value of the key parsed as an int, see Get.
+func GetInt(s Source, key string) *result.Result[option.Option[int]] {
+	return Get(s, key, strconv.Atoi)
+}
+
+// GetBool returns the value of the key parsed as a bool, see Get.
+func GetBool(s Source, key string) *result.Result[option.Option[bool]] {
+	return Get(s, key, strconv.ParseBool)
+}
+
+// GetDuration returns the value of the key parsed as a time.Duration, see Get.
+func GetDuration(s Source, key string) *result.Result[option.Option[time.Duration]] {
+	return Get(s, key, time.ParseDuration)
+}
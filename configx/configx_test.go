@@ -0,0 +1,46 @@
+package configx
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+)
+
+func TestChain(t *testing.T) {
+	t.Setenv("APP_DB_MAX_CONNS", "10")
+	s := Chain(Env("APP_"), Map(map[string]string{"db.max-conns": "5", "timeout": "2s", "debug": "maybe"}))
+
+	if *GetInt(s, "db.max-conns").Unwrap().Unwrap("") != 10 {
+		t.Error("Chain failed")
+	}
+	if *GetDuration(s, "timeout").Unwrap().Unwrap("") != 2*time.Second {
+		t.Error("GetDuration failed")
+	}
+	if GetInt(s, "port").Unwrap().IsSome() {
+		t.Error("GetInt failed on undefined key")
+	}
+	if !errors.Is(GetBool(s, "debug").UnwrapError(), errkind.Invalid) {
+		t.Error("GetBool failed on invalid value")
+	}
+}
+
+func TestFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "app.conf")
+	os.WriteFile(path, []byte("# comment\n\nport = 8080\nname=\n"), 0o644)
+	s := *File(path).Unwrap()
+	if *s.Get("port").Unwrap("") != "8080" || *s.Get("name").Unwrap("") != "" || s.Get("host").IsSome() {
+		t.Error("File failed")
+	}
+
+	os.WriteFile(path, []byte("port\n"), 0o644)
+	if !errors.Is(File(path).UnwrapError(), errkind.Invalid) {
+		t.Error("File accepted a malformed line")
+	}
+	if !errors.Is(File(filepath.Join(t.TempDir(), "missing")).UnwrapError(), errkind.NotFound) {
+		t.Error("File failed on missing file")
+	}
+}
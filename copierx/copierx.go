@@ -0,0 +1,98 @@
+// Package copierx copies between structs of options, e.g. DTOs, and plain structs, e.g. domain models.
+//
+// Fields are matched by name, their `copier` tag overriding their name on either side;
+// fields tagged `copier:"-"` and fields without match are skipped.
+package copierx
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/yuanzicheng/go-result-and-option/internal/fields"
+)
+
+// ErrNotStruct is the error of sources that are not structs, or of destinations that are not pointers to structs.
+var ErrNotStruct = errors.New("copierx: not a struct")
+
+// Flatten copies the struct `src` to the struct pointed to by `dst`: the [`Some`] values of the
+// [`Option`] fields of `src` are set, leaving the fields of its [`None`] fields unchanged,
+// and other fields are copied.
+func Flatten(src, dst any) error {
+	return copyFields(src, dst, func(s, d reflect.Value) error {
+		if fields.IsOption(s.Type()) && !fields.IsOption(d.Type()) {
+			v := fields.OptionValue(s)
+			if v.IsNil() {
+				return nil
+			}
+			s = v.Elem()
+		}
+		return assign(s, d)
+	})
+}
+
+// Lift copies the struct `src` to the struct of options pointed to by `dst`: the [`Option`] fields of `dst`
+// are set to [`Some`] of the fields of `src`, and other fields are copied.
+func Lift(src, dst any) error {
+	return copyFields(src, dst, func(s, d reflect.Value) error {
+		if !fields.IsOption(d.Type()) || fields.IsOption(s.Type()) {
+			return assign(s, d)
+		}
+		v := reflect.New(fields.OptionElem(d.Type()))
+		if err := assign(s, v.Elem()); err != nil {
+			return err
+		}
+		d.Addr().MethodByName("Replace").Call([]reflect.Value{v})
+		return nil
+	})
+}
+
+// copyFields calls `copy` with the pairs of matching fields of `src` and `dst`, joining the errors.
+func copyFields(src, dst any, copy func(s, d reflect.Value) error) error {
+	s := reflect.Indirect(reflect.ValueOf(src))
+	d := reflect.ValueOf(dst)
+	if s.Kind() != reflect.Struct || d.Kind() != reflect.Pointer || d.Elem().Kind() != reflect.Struct {
+		return ErrNotStruct
+	}
+	d = d.Elem()
+	var errs []error
+	st := s.Type()
+	for i := range st.NumField() {
+		name := fields.Name(st.Field(i), "copier")
+		if name == "" {
+			continue
+		}
+		df, ok := field(d, name)
+		if !ok {
+			continue
+		}
+		if err := copy(s.Field(i), df); err != nil {
+			errs = append(errs, fmt.Errorf("copierx: field %s: %w", name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// field returns the field of the struct `v` with the name, by its `copier` tag or its name.
+func field(v reflect.Value, name string) (reflect.Value, bool) {
+	t := v.Type()
+	for i := range t.NumField() {
+		if fields.Name(t.Field(i), "copier") == name {
+			return v.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// assign sets `d` to `s`, converting it between convertible types.
+func assign(s, d reflect.Value) error {
+	switch {
+	case s.Type().AssignableTo(d.Type()):
+		d.Set(s)
+	case s.Type().ConvertibleTo(d.Type()) && s.Kind() != reflect.String && d.Kind() != reflect.String:
+		d.Set(s.Convert(d.Type()))
+	default:
+		return fmt.Errorf("cannot copy %s to %s", s.Type(), d.Type())
+	}
+	return nil
+}
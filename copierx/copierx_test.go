@@ -0,0 +1,68 @@
+package copierx
+
+import (
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+)
+
+type user struct {
+	Name  string
+	Age   int
+	Email string `copier:"Mail"`
+	ID    int64
+}
+
+type userDTO struct {
+	Name option.Option[string]
+	Age  option.Option[int32]
+	Mail option.Option[string]
+	ID   int64
+	Note option.Option[string] `copier:"-"`
+}
+
+func TestFlatten(t *testing.T) {
+	name := "bob"
+	dto := userDTO{Name: *option.Some(&name), ID: 7}
+	u := user{Name: "x", Age: 3, Email: "a@example.com"}
+	if err := Flatten(dto, &u); err != nil {
+		t.Fatal(err)
+	}
+	if u != (user{Name: "bob", Age: 3, Email: "a@example.com", ID: 7}) {
+		t.Errorf("Flatten failed: %+v", u)
+	}
+}
+
+func TestLift(t *testing.T) {
+	var dto userDTO
+	if err := Lift(user{Name: "bob", Age: 0, Email: "a@example.com", ID: 7}, &dto); err != nil {
+		t.Fatal(err)
+	}
+	if *dto.Name.Unwrap("") != "bob" || *dto.Age.Unwrap("") != 0 || *dto.Mail.Unwrap("") != "a@example.com" || dto.ID != 7 || dto.Note.IsSome() {
+		t.Errorf("Lift failed: %+v", dto)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	if Flatten(1, &user{}) != ErrNotStruct || Lift(user{}, userDTO{}) != ErrNotStruct {
+		t.Error("copierx failed to reject a non-struct")
+	}
+	type bad struct{ Name option.Option[int] }
+	if err := Lift(user{Name: "bob"}, &bad{}); err == nil {
+		t.Error("Lift failed to reject mismatched types")
+	}
+}
+
+func TestFlattenDoesNotFireHooks(t *testing.T) {
+	fired := 0
+	option.SetNoneHook(func(option.Meta) { fired++ })
+	defer option.SetNoneHook(nil)
+
+	var u user
+	if err := Flatten(userDTO{ID: 7}, &u); err != nil {
+		t.Fatal(err)
+	}
+	if fired != 0 {
+		t.Errorf("flattening called the hooks %d times", fired)
+	}
+}
@@ -0,0 +1,92 @@
+package csvx
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"iter"
+	"reflect"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/internal/fields"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// RowError is the error of a record that could not be decoded.
+type RowError struct {
+	// Line is the line of the record in the input, starting at 1.
+	Line int
+	// Column is the header of the offending column, empty if the record is malformed.
+	Column string
+	Err    error
+}
+
+func (e *RowError) Error() string {
+	if e.Column == "" {
+		return fmt.Sprintf("csvx: line %d: %v", e.Line, e.Err)
+	}
+	return fmt.Sprintf("csvx: line %d: column %q: %v", e.Line, e.Column, e.Err)
+}
+
+func (e *RowError) Unwrap() error {
+	return e.Err
+}
+
+// Decode returns a sequence of the records of `r` decoded into structs, the first
+// record being the header. Columns are mapped to the fields with a matching `csv` tag
+// or name, case-insensitively, and columns without a field are ignored.
+// Empty cells leave fields to their zero value, [`None`] for `Option` fields.
+//
+// Records that cannot be decoded yield an [`Err`] of `*RowError` tagged with
+// `errkind.Invalid` and decoding goes on; a read error ends the sequence.
+func Decode[T any](r *csv.Reader) iter.Seq[*result.Result[T]] {
+	return func(yield func(*result.Result[T]) bool) {
+		header, err := r.Read()
+		if err != nil {
+			if err != io.EOF {
+				yield(result.Err[T](err))
+			}
+			return
+		}
+		for {
+			record, err := r.Read()
+			if err == io.EOF {
+				return
+			}
+			if err != nil {
+				var pe *csv.ParseError
+				if !errors.As(err, &pe) {
+					yield(result.Err[T](err))
+					return
+				}
+				if !yield(result.Err[T](errkind.Wrap(errkind.Invalid, &RowError{Line: pe.StartLine, Err: pe.Err}))) {
+					return
+				}
+				continue
+			}
+			line, _ := r.FieldPos(0)
+			if !yield(decodeRecord[T](header, record, line)) {
+				return
+			}
+		}
+	}
+}
+
+func decodeRecord[T any](header, record []string, line int) *result.Result[T] {
+	v := new(T)
+	rv := reflect.ValueOf(v).Elem()
+	for i, column := range header {
+		if i >= len(record) || record[i] == "" {
+			continue
+		}
+		f, ok := fields.ByName(rv, "csv", column)
+		if !ok {
+			continue
+		}
+		if err := fields.SetSome(f, record[i]); err != nil {
+			return result.Err[T](errkind.Wrap(errkind.Invalid, &RowError{Line: line, Column: column, Err: err}))
+		}
+	}
+	return result.Ok(v)
+}
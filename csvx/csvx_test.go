@@ -0,0 +1,47 @@
+package csvx
+
+import (
+	"encoding/csv"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/option"
+)
+
+type user struct {
+	Name  string `csv:"name"`
+	Age   option.Option[int]
+	Admin bool `csv:"is_admin"`
+}
+
+func TestDecode(t *testing.T) {
+	in := "name,age,is_admin,extra\nann,42,true,x\nbob,,false,y\ncid,old,false,z\n\"dan,1\n"
+	var users []user
+	var errs []error
+	for r := range Decode[user](csv.NewReader(strings.NewReader(in))) {
+		if r.IsOk() {
+			users = append(users, *r.Unwrap())
+		} else {
+			errs = append(errs, r.UnwrapError())
+		}
+	}
+	if len(users) != 2 || users[0].Name != "ann" || *users[0].Age.Unwrap("") != 42 || !users[0].Admin || users[1].Age.IsSome() {
+		t.Errorf("Decode failed: %+v", users)
+	}
+
+	var re *RowError
+	if len(errs) != 2 || !errors.As(errs[0], &re) || re.Line != 4 || re.Column != "age" || !errors.Is(errs[0], errkind.Invalid) {
+		t.Errorf("Decode failed: %v", errs)
+	}
+	if !errors.As(errs[1], &re) || re.Line != 5 || re.Column != "" {
+		t.Errorf("Decode failed: %v", errs)
+	}
+}
+
+func TestDecodeEmpty(t *testing.T) {
+	for range Decode[user](csv.NewReader(strings.NewReader(""))) {
+		t.Error("Decode yielded on empty input")
+	}
+}
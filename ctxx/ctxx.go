@@ -0,0 +1,39 @@
+package ctxx
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// ErrNoValue is returned by Require for keys without a value of the requested type.
+var ErrNoValue = errors.New("ctxx: no value")
+
+// WithValue returns a copy of `ctx` with the value associated to the key,
+// to be retrieved by Value or Require with the same type `T`.
+func WithValue[T any](ctx context.Context, key any, v T) context.Context {
+	return context.WithValue(ctx, key, v)
+}
+
+// Value returns the value associated to the key, or [`None`] if there is none
+// or it isn't a `T`.
+func Value[T any](ctx context.Context, key any) *option.Option[T] {
+	v, ok := ctx.Value(key).(T)
+	if !ok {
+		return option.None[T]()
+	}
+	return option.Some(&v)
+}
+
+// Require returns the value associated to the key, or an [`Err`] wrapping
+// ErrNoValue if there is none or it isn't a `T`.
+func Require[T any](ctx context.Context, key any) *result.Result[T] {
+	v, ok := ctx.Value(key).(T)
+	if !ok {
+		return result.Err[T](fmt.Errorf("%w of type %T for key %v", ErrNoValue, v, key))
+	}
+	return result.Ok(&v)
+}
@@ -0,0 +1,30 @@
+package ctxx
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+type userKey struct{}
+
+func TestValue(t *testing.T) {
+	ctx := WithValue(context.Background(), userKey{}, "ann")
+	if *Value[string](ctx, userKey{}).Unwrap("") != "ann" {
+		t.Error("Value failed")
+	}
+	if Value[int](ctx, userKey{}).IsSome() || Value[string](context.Background(), userKey{}).IsSome() {
+		t.Error("Value failed on missing value")
+	}
+}
+
+func TestRequire(t *testing.T) {
+	ctx := WithValue(context.Background(), userKey{}, "ann")
+	if *Require[string](ctx, userKey{}).Unwrap() != "ann" {
+		t.Error("Require failed")
+	}
+	err := Require[int](ctx, userKey{}).UnwrapError()
+	if !errors.Is(err, ErrNoValue) || err.Error() != "ctxx: no value of type int for key {}" {
+		t.Errorf("Require failed: %v", err)
+	}
+}
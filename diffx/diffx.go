@@ -0,0 +1,90 @@
+// Package diffx compares structs field by field, e.g. for audit logs,
+// and builds the patches of options turning one into another.
+package diffx
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/internal/fields"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// ErrType is the error of values that are not structs of the same type.
+var ErrType = errors.New("diffx: values are not structs of the same type")
+
+// Changed returns the new values of the exported fields differing between two structs of the same type,
+// or pointers to them, keyed by field name. [`Option`] fields are reported by their contained value,
+// nil for a [`None`]. Returns nil if the values are not structs of the same type.
+func Changed(before, after any) map[string]any {
+	o, n, err := structs(before, after)
+	if err != nil {
+		return nil
+	}
+	changed := map[string]any{}
+	t := o.Type()
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if !f.IsExported() || reflect.DeepEqual(o.Field(i).Interface(), n.Field(i).Interface()) {
+			continue
+		}
+		changed[f.Name] = value(n.Field(i))
+	}
+	return changed
+}
+
+// ToPatch returns the patch of type `P`, e.g. generated by patchgen, turning `before` into `after`:
+// its [`Option`] fields are [`Some`] of the new values of the fields of the same name that changed,
+// and [`None`] otherwise. The errors are tagged with `errkind.Invalid`.
+func ToPatch[P any](before, after any) *result.Result[P] {
+	o, n, err := structs(before, after)
+	if err != nil {
+		return result.Err[P](errkind.Wrap(errkind.Invalid, err))
+	}
+	p := new(P)
+	pv := reflect.ValueOf(p).Elem()
+	if pv.Kind() != reflect.Struct {
+		return result.Err[P](errkind.Wrap(errkind.Invalid, fmt.Errorf("diffx: patch %T is not a struct", *p)))
+	}
+	for i := range pv.NumField() {
+		pf := pv.Type().Field(i)
+		if !pf.IsExported() || !fields.IsOption(pf.Type) {
+			continue
+		}
+		of, nf := o.FieldByName(pf.Name), n.FieldByName(pf.Name)
+		if !nf.IsValid() || reflect.DeepEqual(of.Interface(), nf.Interface()) {
+			continue
+		}
+		elem := fields.OptionElem(pf.Type)
+		if !nf.Type().AssignableTo(elem) {
+			return result.Err[P](errkind.Wrap(errkind.Invalid,
+				fmt.Errorf("diffx: field %s of type %s doesn't fit the patch type %s", pf.Name, nf.Type(), elem)))
+		}
+		v := reflect.New(elem)
+		v.Elem().Set(nf)
+		pv.Field(i).Addr().MethodByName("Replace").Call([]reflect.Value{v})
+	}
+	return result.Ok(p)
+}
+
+func structs(before, after any) (reflect.Value, reflect.Value, error) {
+	o, n := reflect.Indirect(reflect.ValueOf(before)), reflect.Indirect(reflect.ValueOf(after))
+	if o.Kind() != reflect.Struct || n.Kind() != reflect.Struct || o.Type() != n.Type() {
+		return reflect.Value{}, reflect.Value{}, ErrType
+	}
+	return o, n, nil
+}
+
+// value returns the value of a field, unwrapping options.
+func value(f reflect.Value) any {
+	if !fields.IsOption(f.Type()) {
+		return f.Interface()
+	}
+	v := fields.OptionValue(f)
+	if v.IsNil() {
+		return nil
+	}
+	return v.Elem().Interface()
+}
@@ -0,0 +1,52 @@
+package diffx
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/option"
+)
+
+type user struct {
+	Name     string
+	Age      int
+	Tags     []string
+	Nickname option.Option[string]
+	secret   string
+}
+
+type userPatch struct {
+	Name option.Option[string]
+	Age  option.Option[int]
+	Tags option.Option[[]string]
+}
+
+func TestChanged(t *testing.T) {
+	nick := "bob"
+	old := user{Name: "Bob", Age: 1, Tags: []string{"a"}, Nickname: *option.Some(&nick), secret: "x"}
+	new := user{Name: "Bob", Age: 2, Tags: []string{"a"}, secret: "y"}
+	if got := Changed(old, &new); !reflect.DeepEqual(got, map[string]any{"Age": 2, "Nickname": nil}) {
+		t.Errorf("Changed failed: %v", got)
+	}
+	if Changed(old, 1) != nil {
+		t.Error("Changed failed on mismatched types")
+	}
+}
+
+func TestToPatch(t *testing.T) {
+	old := user{Name: "Bob", Age: 1, Tags: []string{"a"}}
+	new := user{Name: "Bob", Age: 2, Tags: []string{"a", "b"}}
+	p := ToPatch[userPatch](old, new).Unwrap()
+	if p.Name.IsSome() || *p.Age.Unwrap("") != 2 || len(*p.Tags.Unwrap("")) != 2 {
+		t.Errorf("ToPatch failed: %+v", p)
+	}
+	type badPatch struct{ Age option.Option[string] }
+	if err := ToPatch[badPatch](old, new).UnwrapError(); !errors.Is(err, errkind.Invalid) {
+		t.Error("ToPatch failed to reject a mismatched patch")
+	}
+	if !errors.Is(ToPatch[userPatch](old, 1).UnwrapError(), ErrType) {
+		t.Error("ToPatch failed on mismatched types")
+	}
+}
@@ -0,0 +1,194 @@
+// Package dumpx renders values holding options and results as readable trees,
+// for test failure messages and debug logging.
+package dumpx
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/yuanzicheng/go-result-and-option/internal/fields"
+	"github.com/yuanzicheng/go-result-and-option/internal/peek"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+var resultPkgPath = reflect.TypeFor[result.Result[int]]().PkgPath()
+
+// Dump renders `v` as an indented tree. Options are rendered as `Some(value)` or `!None`
+// and results as `Ok(value)` or `!Err("message")`, the `!` making the missing and failed
+// branches stand out. Structs show their exported fields, maps their entries sorted by key,
+// and pointers already being rendered on the path from the root are shown as `<cycle>`.
+// Errors and `fmt.Stringer` values are rendered by their message.
+func Dump(v any) string {
+	d := dumper{seen: map[uintptr]bool{}}
+	d.dump(reflect.ValueOf(v), 0)
+	return d.b.String()
+}
+
+type dumper struct {
+	b    strings.Builder
+	seen map[uintptr]bool
+}
+
+func (d *dumper) line(depth int) {
+	d.b.WriteByte('\n')
+	d.b.WriteString(strings.Repeat("  ", depth))
+}
+
+func (d *dumper) dump(v reflect.Value, depth int) {
+	if !v.IsValid() {
+		d.b.WriteString("nil")
+		return
+	}
+	t := v.Type()
+	if isWrapper(t) {
+		p := reflect.New(t)
+		p.Elem().Set(v)
+		d.wrapper(p, depth)
+		return
+	}
+	if t.Kind() == reflect.Pointer && isWrapper(t.Elem()) {
+		if v.IsNil() {
+			d.b.WriteString("nil")
+			return
+		}
+		d.wrapper(v, depth)
+		return
+	}
+	if v.CanInterface() && (t.Kind() != reflect.Pointer || !v.IsNil()) {
+		switch x := v.Interface().(type) {
+		case error:
+			d.b.WriteString(strconv.Quote(x.Error()))
+			return
+		case fmt.Stringer:
+			d.b.WriteString(x.String())
+			return
+		}
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer:
+		if v.IsNil() {
+			d.b.WriteString("nil")
+			return
+		}
+		if !d.seen[v.Pointer()] {
+			d.b.WriteByte('&')
+		}
+		d.elem(v, depth)
+	case reflect.Interface:
+		if v.IsNil() {
+			d.b.WriteString("nil")
+			return
+		}
+		d.dump(v.Elem(), depth)
+	case reflect.Struct:
+		d.b.WriteString(t.String() + "{")
+		n := 0
+		for i := range t.NumField() {
+			if !t.Field(i).IsExported() {
+				continue
+			}
+			n++
+			d.line(depth + 1)
+			d.b.WriteString(t.Field(i).Name + ": ")
+			d.dump(v.Field(i), depth+1)
+		}
+		d.close(n, depth)
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			d.b.WriteString("nil")
+			return
+		}
+		d.b.WriteString(t.String() + "{")
+		for i := range v.Len() {
+			d.line(depth + 1)
+			d.dump(v.Index(i), depth+1)
+		}
+		d.close(v.Len(), depth)
+	case reflect.Map:
+		if v.IsNil() {
+			d.b.WriteString("nil")
+			return
+		}
+		if d.seen[v.Pointer()] {
+			d.b.WriteString("<cycle>")
+			return
+		}
+		d.seen[v.Pointer()] = true
+		defer delete(d.seen, v.Pointer())
+		keys := v.MapKeys()
+		slices.SortFunc(keys, func(a, b reflect.Value) int {
+			return cmp.Compare(fmt.Sprint(a), fmt.Sprint(b))
+		})
+		d.b.WriteString(t.String() + "{")
+		for _, k := range keys {
+			d.line(depth + 1)
+			d.dump(k, depth+1)
+			d.b.WriteString(": ")
+			d.dump(v.MapIndex(k), depth+1)
+		}
+		d.close(len(keys), depth)
+	case reflect.String:
+		d.b.WriteString(strconv.Quote(v.String()))
+	default:
+		if v.CanInterface() {
+			fmt.Fprint(&d.b, v.Interface())
+		} else {
+			d.b.WriteString(v.String())
+		}
+	}
+}
+
+// elem renders the value pointed to by the non-nil pointer `p`,
+// or `<cycle>` if it is already being rendered on the path from the root.
+func (d *dumper) elem(p reflect.Value, depth int) {
+	if d.seen[p.Pointer()] {
+		d.b.WriteString("<cycle>")
+		return
+	}
+	d.seen[p.Pointer()] = true
+	defer delete(d.seen, p.Pointer())
+	d.dump(p.Elem(), depth)
+}
+
+func (d *dumper) close(n, depth int) {
+	if n > 0 {
+		d.line(depth)
+	}
+	d.b.WriteByte('}')
+}
+
+// wrapper renders a non-nil pointer to an option or a result.
+func (d *dumper) wrapper(p reflect.Value, depth int) {
+	if fields.IsOption(p.Type().Elem()) {
+		v := reflect.ValueOf(peek.Option(p.Interface()))
+		if v.IsNil() {
+			d.b.WriteString("!None")
+			return
+		}
+		d.b.WriteString("Some(")
+		d.elem(v, depth)
+		d.b.WriteByte(')')
+		return
+	}
+	value, err := peek.Result(p.Interface())
+	if err != nil {
+		d.b.WriteString("!Err(" + strconv.Quote(err.Error()) + ")")
+		return
+	}
+	d.b.WriteString("Ok(")
+	if v := reflect.ValueOf(value); v.IsNil() {
+		d.b.WriteString("nil")
+	} else {
+		d.elem(v, depth)
+	}
+	d.b.WriteByte(')')
+}
+
+func isWrapper(t reflect.Type) bool {
+	return fields.IsOption(t) || t.PkgPath() == resultPkgPath && strings.HasPrefix(t.Name(), "Result[")
+}
@@ -0,0 +1,112 @@
+package dumpx
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+type address struct {
+	City string
+	Zip  option.Option[string]
+}
+
+type user struct {
+	Name    string
+	Age     option.Option[int]
+	Email   option.Option[string]
+	Address *address
+	Tags    []string
+	Scores  map[string]*result.Result[int]
+	Friend  *user
+	secret  string
+}
+
+func TestDump(t *testing.T) {
+	age, score := 30, 7
+	u := &user{
+		Name:    "ann",
+		Age:     *option.Some(&age),
+		Address: &address{City: "Paris"},
+		Tags:    []string{"a"},
+		Scores:  map[string]*result.Result[int]{"b": result.Err[int](errors.New("boom")), "a": result.Ok(&score)},
+		secret:  "hidden",
+	}
+	u.Friend = u
+	want := `&dumpx.user{
+  Name: "ann"
+  Age: Some(30)
+  Email: !None
+  Address: &dumpx.address{
+    City: "Paris"
+    Zip: !None
+  }
+  Tags: []string{
+    "a"
+  }
+  Scores: map[string]*result.Result[int]{
+    "a": Ok(7)
+    "b": !Err("boom")
+  }
+  Friend: <cycle>
+}`
+	if got := Dump(u); got != want {
+		t.Errorf("Dump =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestDumpScalars(t *testing.T) {
+	x := 1
+	for _, c := range []struct {
+		v    any
+		want string
+	}{
+		{nil, "nil"},
+		{"s", `"s"`},
+		{errors.New("boom"), `"boom"`},
+		{option.None[int](), "!None"},
+		{result.Ok[int](nil), "Ok(nil)"},
+		{result.Ok(&x), "Ok(1)"},
+		{[]int(nil), "nil"},
+		{struct{}{}, "struct {}{}"},
+	} {
+		if got := Dump(c.v); got != c.want {
+			t.Errorf("Dump(%#v) = %s, want %s", c.v, got, c.want)
+		}
+	}
+}
+
+type node struct {
+	Name string
+	Next *option.Option[node]
+	Res  *result.Result[node]
+}
+
+func TestDumpWrapperCycle(t *testing.T) {
+	n := &node{Name: "n"}
+	n.Next = option.Some(n)
+	n.Res = result.Ok(n)
+	want := `&dumpx.node{
+  Name: "n"
+  Next: Some(<cycle>)
+  Res: Ok(<cycle>)
+}`
+	if got := Dump(n); got != want {
+		t.Errorf("Dump =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestDumpDoesNotFireHooks(t *testing.T) {
+	v := []any{result.Err[int](errors.New("boom")), option.None[int]()}
+	fired := 0
+	result.SetErrHook(func(error, result.Meta) { fired++ })
+	defer result.SetErrHook(nil)
+	option.SetNoneHook(func(option.Meta) { fired++ })
+	defer option.SetNoneHook(nil)
+	Dump(v)
+	if fired != 0 {
+		t.Errorf("Dump called the hooks %d times", fired)
+	}
+}
@@ -0,0 +1,42 @@
+package encodingx
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// DecodeBase64 decodes standard padded base64, as `base64.StdEncoding` does.
+func DecodeBase64(s string) *result.Result[[]byte] {
+	data, err := base64.StdEncoding.DecodeString(s)
+	return result.New(&data, err)
+}
+
+// EncodeBase64 encodes to standard padded base64.
+func EncodeBase64(data []byte) string {
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+// DecodeBase64URL decodes unpadded URL-safe base64, as found in JWTs,
+// as `base64.RawURLEncoding` does.
+func DecodeBase64URL(s string) *result.Result[[]byte] {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	return result.New(&data, err)
+}
+
+// EncodeBase64URL encodes to unpadded URL-safe base64.
+func EncodeBase64URL(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeHex decodes hexadecimal, as `hex.DecodeString` does.
+func DecodeHex(s string) *result.Result[[]byte] {
+	data, err := hex.DecodeString(s)
+	return result.New(&data, err)
+}
+
+// EncodeHex encodes to lowercase hexadecimal.
+func EncodeHex(data []byte) string {
+	return hex.EncodeToString(data)
+}
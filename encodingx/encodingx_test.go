@@ -0,0 +1,16 @@
+package encodingx
+
+import "testing"
+
+func TestEncoding(t *testing.T) {
+	data := []byte{0xfb, 0xff, 0x01}
+	if string(*DecodeBase64(EncodeBase64(data)).Unwrap()) != string(data) || DecodeBase64("!").IsOk() {
+		t.Error("Base64 failed")
+	}
+	if EncodeBase64URL(data) != "-_8B" || string(*DecodeBase64URL("-_8B").Unwrap()) != string(data) {
+		t.Error("Base64URL failed")
+	}
+	if EncodeHex(data) != "fbff01" || string(*DecodeHex("fbff01").Unwrap()) != string(data) || DecodeHex("f").IsOk() {
+		t.Error("Hex failed")
+	}
+}
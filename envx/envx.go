@@ -0,0 +1,80 @@
+package envx
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/internal/fields"
+	"github.com/yuanzicheng/go-result-and-option/osx"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// VarError is the error of an environment variable that is missing or could not be parsed.
+type VarError struct {
+	Name string
+	Err  error
+}
+
+func (e *VarError) Error() string {
+	return fmt.Sprintf("envx: %s: %v", e.Name, e.Err)
+}
+
+func (e *VarError) Unwrap() error {
+	return e.Err
+}
+
+// Load returns a struct populated from the environment. Fields are read from the
+// variable named by their `env` tag, or their upper-cased name, and the `required`
+// tag option makes an unset variable an error, e.g. `env:"PORT,required"`.
+// `Option` fields are [`None`] when their variable is unset and [`Some`] when it is set,
+// even to the empty string; other fields are left to their zero value when unset.
+//
+// The errors of all the invalid variables are joined as `*VarError` errors tagged
+// with `errkind.Invalid`, missing required variables wrapping `osx.ErrEnvNotSet`.
+func Load[T any]() *result.Result[T] {
+	return LoadPrefix[T]("")
+}
+
+// LoadPrefix is like Load, prefixing all the variable names with `prefix`.
+func LoadPrefix[T any](prefix string) *result.Result[T] {
+	v := new(T)
+	rv := reflect.ValueOf(v).Elem()
+	if rv.Kind() != reflect.Struct {
+		return result.Err[T](fmt.Errorf("envx: %v is not a struct", rv.Type()))
+	}
+
+	var errs []error
+	t := rv.Type()
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		name := fields.Name(sf, "env")
+		if name == "" {
+			continue
+		}
+		tagName, opts, _ := strings.Cut(sf.Tag.Get("env"), ",")
+		if tagName == "" {
+			name = strings.ToUpper(name)
+		}
+		name = prefix + name
+
+		s, ok := os.LookupEnv(name)
+		if !ok {
+			if slices.Contains(strings.Split(opts, ","), "required") {
+				errs = append(errs, &VarError{Name: name, Err: osx.ErrEnvNotSet})
+			}
+			continue
+		}
+		if err := fields.SetSome(rv.Field(i), s); err != nil {
+			errs = append(errs, &VarError{Name: name, Err: err})
+		}
+	}
+	if len(errs) > 0 {
+		return result.Err[T](errkind.Wrap(errkind.Invalid, errors.Join(errs...)))
+	}
+	return result.Ok(v)
+}
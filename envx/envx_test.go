@@ -0,0 +1,60 @@
+package envx
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/osx"
+)
+
+type config struct {
+	Port    int `env:"PORT,required"`
+	Timeout option.Option[time.Duration]
+	Name    option.Option[string] `env:"NAME"`
+	Debug   bool
+	Ignored string `env:"-"`
+}
+
+func TestLoad(t *testing.T) {
+	t.Setenv("APP_PORT", "8080")
+	t.Setenv("APP_NAME", "")
+	t.Setenv("APP_DEBUG", "true")
+	c := LoadPrefix[config]("APP_").Unwrap()
+	if c.Port != 8080 || c.Timeout.IsSome() || *c.Name.Unwrap("") != "" || !c.Debug {
+		t.Errorf("Load failed: %+v", c)
+	}
+
+	t.Setenv("TIMEOUT", "soon")
+	err := Load[config]().UnwrapError()
+	var ve *VarError
+	if !errors.Is(err, errkind.Invalid) || !errors.Is(err, osx.ErrEnvNotSet) || !errors.As(err, &ve) || ve.Name != "PORT" {
+		t.Errorf("Load failed: %v", err)
+	}
+	if len(err.(interface{ Unwrap() error }).Unwrap().(interface{ Unwrap() []error }).Unwrap()) != 2 {
+		t.Errorf("Load didn't collect all errors: %v", err)
+	}
+}
+
+func TestLoadTagOptionsOnly(t *testing.T) {
+	type options struct {
+		Port int    `env:",required"`
+		Host string `env:",omitempty,required"`
+	}
+	t.Setenv("PORT", "80")
+	t.Setenv("HOST", "example.com")
+	r := Load[options]()
+	if !r.IsOk() || r.Unwrap().Port != 80 || r.Unwrap().Host != "example.com" {
+		t.Errorf("Load did not upper-case the names: %v", r.UnwrapOr(&options{}))
+	}
+
+	type missing struct {
+		Token string `env:",omitempty,required"`
+	}
+	var ve *VarError
+	if err := Load[missing]().UnwrapError(); !errors.As(err, &ve) || ve.Name != "TOKEN" {
+		t.Errorf("Load did not require TOKEN: %v", err)
+	}
+}
@@ -0,0 +1,112 @@
+package errkind
+
+import "net/http"
+
+// httpStatuses maps the kinds to the HTTP status codes servers respond with.
+var httpStatuses = map[Kind]int{
+	NotFound:     http.StatusNotFound,
+	Invalid:      http.StatusBadRequest,
+	Conflict:     http.StatusConflict,
+	Unauthorized: http.StatusUnauthorized,
+	Unavailable:  http.StatusServiceUnavailable,
+	Timeout:      http.StatusGatewayTimeout,
+	Internal:     http.StatusInternalServerError,
+}
+
+// httpKinds maps the HTTP status codes to kinds, besides the 4xx and 5xx defaults.
+var httpKinds = map[int]Kind{
+	http.StatusNotFound:           NotFound,
+	http.StatusGone:               NotFound,
+	http.StatusUnauthorized:       Unauthorized,
+	http.StatusForbidden:          Unauthorized,
+	http.StatusConflict:           Conflict,
+	http.StatusPreconditionFailed: Conflict,
+	http.StatusRequestTimeout:     Timeout,
+	http.StatusGatewayTimeout:     Timeout,
+	http.StatusTooManyRequests:    Unavailable,
+	http.StatusBadGateway:         Unavailable,
+	http.StatusServiceUnavailable: Unavailable,
+}
+
+// HTTPStatus returns the HTTP status code of a kind, 500 for unknown kinds.
+func HTTPStatus(kind Kind) int {
+	if code, ok := httpStatuses[kind]; ok {
+		return code
+	}
+	return http.StatusInternalServerError
+}
+
+// OfHTTPStatus returns the kind of a 4xx or 5xx HTTP status code, or the empty kind otherwise.
+func OfHTTPStatus(code int) Kind {
+	if kind, ok := httpKinds[code]; ok {
+		return kind
+	}
+	switch {
+	case code >= 400 && code < 500:
+		return Invalid
+	case code >= 500 && code < 600:
+		return Internal
+	}
+	return ""
+}
+
+// The gRPC codes, as defined by `google.golang.org/grpc/codes`, which this package doesn't depend on.
+const (
+	grpcUnknown            uint32 = 2
+	grpcInvalidArgument    uint32 = 3
+	grpcDeadlineExceeded   uint32 = 4
+	grpcNotFound           uint32 = 5
+	grpcAlreadyExists      uint32 = 6
+	grpcPermissionDenied   uint32 = 7
+	grpcResourceExhausted  uint32 = 8
+	grpcFailedPrecondition uint32 = 9
+	grpcAborted            uint32 = 10
+	grpcOutOfRange         uint32 = 11
+	grpcUnimplemented      uint32 = 12
+	grpcInternal           uint32 = 13
+	grpcUnavailable        uint32 = 14
+	grpcDataLoss           uint32 = 15
+	grpcUnauthenticated    uint32 = 16
+)
+
+// grpcCodes maps the kinds to the gRPC codes servers respond with.
+var grpcCodes = map[Kind]uint32{
+	NotFound:     grpcNotFound,
+	Invalid:      grpcInvalidArgument,
+	Conflict:     grpcAborted,
+	Unauthorized: grpcUnauthenticated,
+	Unavailable:  grpcUnavailable,
+	Timeout:      grpcDeadlineExceeded,
+	Internal:     grpcInternal,
+}
+
+// grpcKinds maps the gRPC codes to kinds.
+var grpcKinds = map[uint32]Kind{
+	grpcNotFound:           NotFound,
+	grpcInvalidArgument:    Invalid,
+	grpcOutOfRange:         Invalid,
+	grpcFailedPrecondition: Invalid,
+	grpcAborted:            Conflict,
+	grpcAlreadyExists:      Conflict,
+	grpcUnauthenticated:    Unauthorized,
+	grpcPermissionDenied:   Unauthorized,
+	grpcUnavailable:        Unavailable,
+	grpcResourceExhausted:  Unavailable,
+	grpcDeadlineExceeded:   Timeout,
+	grpcInternal:           Internal,
+	grpcDataLoss:           Internal,
+	grpcUnimplemented:      Internal,
+}
+
+// GRPCCode returns the gRPC code of a kind, `Unknown` for unknown kinds.
+func GRPCCode(kind Kind) uint32 {
+	if code, ok := grpcCodes[kind]; ok {
+		return code
+	}
+	return grpcUnknown
+}
+
+// OfGRPCCode returns the kind of a gRPC code, or the empty kind for `OK`, `Canceled` and `Unknown`.
+func OfGRPCCode(code uint32) Kind {
+	return grpcKinds[code]
+}
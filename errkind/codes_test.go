@@ -0,0 +1,31 @@
+package errkind
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestHTTPStatus(t *testing.T) {
+	for _, kind := range []Kind{NotFound, Invalid, Conflict, Unauthorized, Unavailable, Timeout, Internal} {
+		if OfHTTPStatus(HTTPStatus(kind)) != kind {
+			t.Errorf("HTTPStatus failed to round trip %s", kind)
+		}
+	}
+	if HTTPStatus("") != http.StatusInternalServerError {
+		t.Error("HTTPStatus failed on an unknown kind")
+	}
+	if OfHTTPStatus(http.StatusTeapot) != Invalid || OfHTTPStatus(http.StatusOK) != "" {
+		t.Error("OfHTTPStatus failed")
+	}
+}
+
+func TestGRPCCode(t *testing.T) {
+	for _, kind := range []Kind{NotFound, Invalid, Conflict, Unauthorized, Unavailable, Timeout, Internal} {
+		if OfGRPCCode(GRPCCode(kind)) != kind {
+			t.Errorf("GRPCCode failed to round trip %s", kind)
+		}
+	}
+	if GRPCCode("") != grpcUnknown || OfGRPCCode(0) != "" {
+		t.Error("GRPCCode failed on an unknown kind")
+	}
+}
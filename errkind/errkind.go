@@ -0,0 +1,88 @@
+package errkind
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Kind classifies errors independently of their origin.
+// A Kind is itself an error, so `errors.Is(err, errkind.NotFound)` reports whether `err` is of that kind.
+type Kind string
+
+const (
+	// NotFound means the requested entity doesn't exist.
+	NotFound Kind = "not_found"
+	// Invalid means the request or the input is malformed.
+	Invalid Kind = "invalid"
+	// Conflict means the request conflicts with the current state.
+	Conflict Kind = "conflict"
+	// Unauthorized means the caller isn't authenticated or allowed.
+	Unauthorized Kind = "unauthorized"
+	// Unavailable means the service is temporarily unable to handle the request.
+	Unavailable Kind = "unavailable"
+	// Timeout means the operation didn't complete in time.
+	Timeout Kind = "timeout"
+	// Internal means an unexpected failure.
+	Internal Kind = "internal"
+)
+
+// Kinds returns the kinds defined by this package, in declaration order.
+func Kinds() []Kind {
+	return []Kind{NotFound, Invalid, Conflict, Unauthorized, Unavailable, Timeout, Internal}
+}
+
+func (k Kind) Error() string {
+	return string(k)
+}
+
+// Error is an error tagged with a kind.
+type Error struct {
+	Kind Kind
+	Err  error
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether `target` is the kind of the error.
+func (e *Error) Is(target error) bool {
+	k, ok := target.(Kind)
+	return ok && k == e.Kind
+}
+
+// Wrap tags the error with a kind, returns nil if `err` is nil.
+func Wrap(kind Kind, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &Error{Kind: kind, Err: err}
+}
+
+// New returns an error of the kind with the message.
+func New(kind Kind, msg string) error {
+	return &Error{Kind: kind, Err: errors.New(msg)}
+}
+
+// Errorf returns an error of the kind formatted like `fmt.Errorf`, `%w` included.
+func Errorf(kind Kind, format string, args ...any) error {
+	return &Error{Kind: kind, Err: fmt.Errorf(format, args...)}
+}
+
+// KindOf returns the kind of the first error in the chain of `err` tagged with one,
+// or the empty kind if there is none.
+func KindOf(err error) Kind {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Kind
+	}
+	var k Kind
+	if errors.As(err, &k) {
+		return k
+	}
+	return ""
+}
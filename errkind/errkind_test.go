@@ -0,0 +1,39 @@
+package errkind
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestWrap(t *testing.T) {
+	base := errors.New("no such user")
+	err := fmt.Errorf("loading: %w", Wrap(NotFound, base))
+	if !errors.Is(err, NotFound) || !errors.Is(err, base) || KindOf(err) != NotFound {
+		t.Error("Wrap failed")
+	}
+	if KindOf(base) != "" || Wrap(NotFound, nil) != nil {
+		t.Error("KindOf failed")
+	}
+	if KindOf(fmt.Errorf("loading: %w", NotFound)) != NotFound {
+		t.Error("KindOf failed on a bare kind")
+	}
+}
+
+func TestNew(t *testing.T) {
+	base := errors.New("no such user")
+	err := Errorf(NotFound, "loading %d: %w", 1, base)
+	if KindOf(err) != NotFound || !errors.Is(err, base) || err.Error() != "loading 1: no such user" {
+		t.Error("Errorf failed")
+	}
+	if err := New(Conflict, "taken"); KindOf(err) != Conflict || err.Error() != "taken" {
+		t.Error("New failed")
+	}
+}
+
+func TestKinds(t *testing.T) {
+	kinds := Kinds()
+	if len(kinds) != len(httpStatuses) || kinds[0] != NotFound || kinds[len(kinds)-1] != Internal {
+		t.Errorf("Kinds = %v", kinds)
+	}
+}
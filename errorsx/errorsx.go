@@ -0,0 +1,58 @@
+package errorsx
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+)
+
+// Error is an error wrapped with a message and the location it was wrapped at.
+type Error struct {
+	Msg  string
+	File string
+	Line int
+	Err  error
+}
+
+func (e *Error) Error() string {
+	return e.Msg + ": " + e.Err.Error()
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// Location returns the `file:line` the error was wrapped at, with the base name of the file.
+func (e *Error) Location() string {
+	return fmt.Sprintf("%s:%d", filepath.Base(e.File), e.Line)
+}
+
+// Format formats the error like its message, with the locations of the wrapped
+// errors for the `%+v` verb, e.g. `loading config (main.go:12): reading file (config.go:30): EOF`.
+func (e *Error) Format(s fmt.State, verb rune) {
+	if verb == 'v' && s.Flag('+') {
+		fmt.Fprintf(s, "%s (%s): %+v", e.Msg, e.Location(), e.Err)
+		return
+	}
+	fmt.Fprint(s, e.Error())
+}
+
+// Wrap returns `err` wrapped with the message and the location of the caller, or nil if `err` is nil.
+func Wrap(err error, msg string) error {
+	return WrapDepth(1, err, msg)
+}
+
+// Wrapf is like Wrap with a message formatted by fmt.Sprintf.
+func Wrapf(err error, format string, args ...any) error {
+	return WrapDepth(1, err, fmt.Sprintf(format, args...))
+}
+
+// WrapDepth is like Wrap with the location of a caller `depth` frames above the caller of WrapDepth,
+// for helpers wrapping errors on behalf of their callers.
+func WrapDepth(depth int, err error, msg string) error {
+	if err == nil {
+		return nil
+	}
+	_, file, line, _ := runtime.Caller(depth + 1)
+	return &Error{Msg: msg, File: file, Line: line, Err: err}
+}
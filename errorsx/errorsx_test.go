@@ -0,0 +1,29 @@
+package errorsx
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+)
+
+func TestWrap(t *testing.T) {
+	if Wrap(nil, "reading") != nil {
+		t.Error("Wrap failed on nil")
+	}
+	err := Wrapf(Wrap(io.EOF, "reading file"), "loading %s", "config")
+	if err.Error() != "loading config: reading file: EOF" || !errors.Is(err, io.EOF) {
+		t.Errorf("Wrap failed: %v", err)
+	}
+	if s := fmt.Sprintf("%+v", err); s != "loading config (errorsx_test.go:14): reading file (errorsx_test.go:14): EOF" {
+		t.Errorf("Format failed: %s", s)
+	}
+}
+
+func TestWrapDepth(t *testing.T) {
+	helper := func(err error) error { return WrapDepth(1, err, "helper") }
+	var e *Error
+	if !errors.As(helper(io.EOF), &e) || e.Location() != "errorsx_test.go:26" {
+		t.Errorf("WrapDepth failed: %v", e.Location())
+	}
+}
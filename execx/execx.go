@@ -0,0 +1,80 @@
+package execx
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// Error is the error of a failed command.
+type Error struct {
+	Args []string
+	// ExitCode is -1 if the command didn't start or was killed by a signal.
+	ExitCode int
+	// Stderr holds the standard error of the command,
+	// or its combined output for CombinedOutput.
+	Stderr []byte
+	Err    error
+}
+
+func (e *Error) Error() string {
+	msg := fmt.Sprintf("%s: %v", strings.Join(e.Args, " "), e.Err)
+	if stderr := bytes.TrimSpace(e.Stderr); len(stderr) > 0 {
+		msg += ": " + string(stderr)
+	}
+	return msg
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+func newError(cmd *exec.Cmd, stderr []byte, err error) error {
+	e := &Error{Args: cmd.Args, ExitCode: -1, Stderr: stderr, Err: err}
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		e.ExitCode = exitErr.ExitCode()
+	}
+	return e
+}
+
+// Output runs the command and returns its standard output.
+// On failure, returns an [`Err`] of `*Error` holding the exit code and standard error.
+func Output(ctx context.Context, name string, args ...string) *result.Result[[]byte] {
+	cmd := exec.CommandContext(ctx, name, args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return result.Err[[]byte](newError(cmd, stderr.Bytes(), err))
+	}
+	return result.Ok(&out)
+}
+
+// CombinedOutput runs the command and returns its combined standard output and standard error.
+// On failure, returns an [`Err`] of `*Error` holding the exit code and the combined output.
+func CombinedOutput(ctx context.Context, name string, args ...string) *result.Result[[]byte] {
+	cmd := exec.CommandContext(ctx, name, args...)
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return result.Err[[]byte](newError(cmd, out, err))
+	}
+	return result.Ok(&out)
+}
+
+// Run runs the command, discarding its standard output.
+// On failure, returns an [`Err`] of `*Error` holding the exit code and standard error.
+func Run(ctx context.Context, name string, args ...string) *result.Result[struct{}] {
+	cmd := exec.CommandContext(ctx, name, args...)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return result.Err[struct{}](newError(cmd, stderr.Bytes(), err))
+	}
+	return result.Ok(&struct{}{})
+}
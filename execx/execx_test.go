@@ -0,0 +1,26 @@
+package execx
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestOutput(t *testing.T) {
+	ctx := context.Background()
+	if string(*Output(ctx, "sh", "-c", "echo hi").Unwrap()) != "hi\n" {
+		t.Error("Output failed")
+	}
+
+	var e *Error
+	err := Output(ctx, "sh", "-c", "echo oops >&2; exit 3").UnwrapError()
+	if !errors.As(err, &e) || e.ExitCode != 3 || string(e.Stderr) != "oops\n" {
+		t.Errorf("Output failed: %v", err)
+	}
+	if !errors.As(Run(ctx, "execx-no-such-command").UnwrapError(), &e) || e.ExitCode != -1 {
+		t.Error("Run failed")
+	}
+	if string(*CombinedOutput(ctx, "sh", "-c", "echo a; echo b >&2").Unwrap()) != "a\nb\n" {
+		t.Error("CombinedOutput failed")
+	}
+}
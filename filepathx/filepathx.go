@@ -0,0 +1,48 @@
+package filepathx
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// Glob returns the names of all files matching the pattern, as `filepath.Glob` does.
+func Glob(pattern string) *result.Result[[]string] {
+	matches, err := filepath.Glob(pattern)
+	return result.New(&matches, err)
+}
+
+// Abs returns an absolute representation of the path, as `filepath.Abs` does.
+func Abs(path string) *result.Result[string] {
+	abs, err := filepath.Abs(path)
+	return result.New(&abs, err)
+}
+
+// Rel returns a relative path that is lexically equivalent to `target`
+// when joined to `base`, as `filepath.Rel` does.
+func Rel(base, target string) *result.Result[string] {
+	rel, err := filepath.Rel(base, target)
+	return result.New(&rel, err)
+}
+
+// FindUp looks for `name` in `dir` and its parent directories,
+// returning the path of the first one found, or [`None`].
+func FindUp(dir, name string) *option.Option[string] {
+	dir, err := filepath.Abs(dir)
+	if err != nil {
+		return option.None[string]()
+	}
+	for {
+		path := filepath.Join(dir, name)
+		if _, err := os.Stat(path); err == nil {
+			return option.Some(&path)
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return option.None[string]()
+		}
+		dir = parent
+	}
+}
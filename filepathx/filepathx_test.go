@@ -0,0 +1,30 @@
+package filepathx
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFindUp(t *testing.T) {
+	root := t.TempDir()
+	deep := filepath.Join(root, "a", "b")
+	os.MkdirAll(deep, 0o755)
+	os.WriteFile(filepath.Join(root, "go.mod"), nil, 0o644)
+
+	if *FindUp(deep, "go.mod").Unwrap("") != filepath.Join(root, "go.mod") {
+		t.Error("FindUp failed")
+	}
+	if FindUp(deep, "filepathx-no-such-file").IsSome() {
+		t.Error("FindUp failed")
+	}
+	if len(*Glob(filepath.Join(root, "*.mod")).Unwrap()) != 1 || Glob("[").IsOk() {
+		t.Error("Glob failed")
+	}
+	if *Rel(root, deep).Unwrap() != filepath.Join("a", "b") || Rel("a", "/b").IsOk() {
+		t.Error("Rel failed")
+	}
+	if !filepath.IsAbs(*Abs(".").Unwrap()) {
+		t.Error("Abs failed")
+	}
+}
@@ -0,0 +1,87 @@
+package flagx
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+)
+
+type optionValue[T any] struct {
+	o      *option.Option[T]
+	parse  func(string) (T, error)
+	isBool bool
+}
+
+func (v *optionValue[T]) String() string {
+	if v.o == nil || v.o.IsNone() {
+		return ""
+	}
+	return fmt.Sprint(*v.o.Unwrap(""))
+}
+
+func (v *optionValue[T]) Set(s string) error {
+	x, err := v.parse(s)
+	if err != nil {
+		return err
+	}
+	v.o.Replace(&x)
+	return nil
+}
+
+func (v *optionValue[T]) IsBoolFlag() bool {
+	return v.isBool
+}
+
+// Func defines a flag parsed by `parse`, returning an option that stays [`None`]
+// unless the flag is passed on the command line. Flags passed several times keep their last value.
+func Func[T any](fs *flag.FlagSet, name, usage string, parse func(string) (T, error)) *option.Option[T] {
+	o := option.None[T]()
+	fs.Var(&optionValue[T]{o: o, parse: parse}, name, usage)
+	return o
+}
+
+// String defines a string flag, see Func.
+func String(fs *flag.FlagSet, name, usage string) *option.Option[string] {
+	return Func(fs, name, usage, func(s string) (string, error) { return s, nil })
+}
+
+// Int defines an int flag, see Func.
+func Int(fs *flag.FlagSet, name, usage string) *option.Option[int] {
+	return Func(fs, name, usage, func(s string) (int, error) {
+		n, err := strconv.ParseInt(s, 0, strconv.IntSize)
+		return int(n), err
+	})
+}
+
+// Int64 defines an int64 flag, see Func.
+func Int64(fs *flag.FlagSet, name, usage string) *option.Option[int64] {
+	return Func(fs, name, usage, func(s string) (int64, error) { return strconv.ParseInt(s, 0, 64) })
+}
+
+// Uint defines a uint flag, see Func.
+func Uint(fs *flag.FlagSet, name, usage string) *option.Option[uint] {
+	return Func(fs, name, usage, func(s string) (uint, error) {
+		n, err := strconv.ParseUint(s, 0, strconv.IntSize)
+		return uint(n), err
+	})
+}
+
+// Float64 defines a float64 flag, see Func.
+func Float64(fs *flag.FlagSet, name, usage string) *option.Option[float64] {
+	return Func(fs, name, usage, func(s string) (float64, error) { return strconv.ParseFloat(s, 64) })
+}
+
+// Duration defines a time.Duration flag, see Func.
+func Duration(fs *flag.FlagSet, name, usage string) *option.Option[time.Duration] {
+	return Func(fs, name, usage, time.ParseDuration)
+}
+
+// Bool defines a bool flag, which can be passed as `-name` for true, see Func.
+func Bool(fs *flag.FlagSet, name, usage string) *option.Option[bool] {
+	o := option.None[bool]()
+	fs.Var(&optionValue[bool]{o: o, parse: strconv.ParseBool, isBool: true}, name, usage)
+	return o
+}
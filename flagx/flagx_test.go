@@ -0,0 +1,33 @@
+package flagx
+
+import (
+	"flag"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestFlags(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	name := String(fs, "name", "")
+	count := Int(fs, "count", "")
+	verbose := Bool(fs, "v", "")
+	timeout := Duration(fs, "timeout", "")
+	if err := fs.Parse([]string{"-name=", "-count", "0x10", "-v"}); err != nil {
+		t.Fatal(err)
+	}
+	if *name.Unwrap("") != "" || *count.Unwrap("") != 16 || !*verbose.Unwrap("") || timeout.IsSome() {
+		t.Error("flags failed")
+	}
+	if fs.Parse([]string{"-timeout", "soon"}) == nil || timeout.IsSome() {
+		t.Error("flags accepted an invalid value")
+	}
+
+	var b strings.Builder
+	fs.SetOutput(&b)
+	fs.PrintDefaults()
+	if strings.Contains(b.String(), "default") {
+		t.Errorf("flags printed a default: %s", b.String())
+	}
+}
@@ -0,0 +1,59 @@
+package formx
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/internal/fields"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// FieldError is the error of a parameter that could not be parsed.
+type FieldError struct {
+	Field string
+	Err   error
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("formx: parameter %q: %v", e.Field, e.Err)
+}
+
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// Decode returns a struct populated from the values, e.g. `r.Form` or `u.Query()`.
+// Parameters are mapped to the fields with a matching `form` tag or name, case-sensitively.
+// `Option` fields are [`None`] when their parameter is absent and [`Some`] when it is
+// present, even if empty. Slice fields get all the values of their parameter,
+// other fields the first one.
+//
+// The errors of all the fields that could not be parsed are joined as `*FieldError`
+// errors tagged with `errkind.Invalid`.
+func Decode[T any](values url.Values) *result.Result[T] {
+	v := new(T)
+	rv := reflect.ValueOf(v).Elem()
+	if rv.Kind() != reflect.Struct {
+		return result.Err[T](fmt.Errorf("formx: %v is not a struct", rv.Type()))
+	}
+
+	var errs []error
+	t := rv.Type()
+	for i := range t.NumField() {
+		name := fields.Name(t.Field(i), "form")
+		vs, ok := values[name]
+		if name == "" || !ok || len(vs) == 0 {
+			continue
+		}
+		if err := fields.SetAll(rv.Field(i), vs); err != nil {
+			errs = append(errs, &FieldError{Field: name, Err: err})
+		}
+	}
+	if len(errs) > 0 {
+		return result.Err[T](errkind.Wrap(errkind.Invalid, errors.Join(errs...)))
+	}
+	return result.Ok(v)
+}
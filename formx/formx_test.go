@@ -0,0 +1,36 @@
+package formx
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/option"
+)
+
+type search struct {
+	Query string                `form:"q"`
+	Page  option.Option[int]    `form:"page"`
+	Sort  option.Option[string] `form:"sort"`
+	Tags  []string              `form:"tag"`
+}
+
+func TestDecode(t *testing.T) {
+	values, _ := url.ParseQuery("q=go&sort=&tag=a&tag=b")
+	s := Decode[search](values).Unwrap()
+	if s.Query != "go" || s.Page.IsSome() || *s.Sort.Unwrap("") != "" || len(s.Tags) != 2 || s.Tags[1] != "b" {
+		t.Errorf("Decode failed: %+v", s)
+	}
+
+	values, _ = url.ParseQuery("q=go&page=x&tag=a")
+	err := Decode[search](values).UnwrapError()
+	var fe *FieldError
+	if !errors.Is(err, errkind.Invalid) || !errors.As(err, &fe) || fe.Field != "page" {
+		t.Errorf("Decode failed: %v", err)
+	}
+
+	if Decode[int](values).IsOk() {
+		t.Error("Decode accepted a non-struct type")
+	}
+}
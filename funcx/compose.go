@@ -0,0 +1,34 @@
+package funcx
+
+import (
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// Compose returns a function calling `f`, then `g` with its [`Ok`] value.
+func Compose[A any, B any, C any](f func(*A) *result.Result[B], g func(*B) *result.Result[C]) func(*A) *result.Result[C] {
+	return func(v *A) *result.Result[C] {
+		return result.AndThen(f(v), g)
+	}
+}
+
+// ComposeOption returns a function calling `f`, then `g` with its [`Some`] value.
+func ComposeOption[A any, B any, C any](f func(*A) *option.Option[B], g func(*B) *option.Option[C]) func(*A) *option.Option[C] {
+	return func(v *A) *option.Option[C] {
+		o := f(v)
+		if o.IsNone() {
+			return option.None[C]()
+		}
+		return g(o.UnwrapOrDefault())
+	}
+}
+
+// Curry turns a fallible function of two arguments into a chain of functions of one argument,
+// so the first argument can be bound ahead of time.
+func Curry[A any, B any, C any](f func(*A, *B) *result.Result[C]) func(*A) func(*B) *result.Result[C] {
+	return func(a *A) func(*B) *result.Result[C] {
+		return func(b *B) *result.Result[C] {
+			return f(a, b)
+		}
+	}
+}
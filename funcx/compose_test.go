@@ -0,0 +1,45 @@
+package funcx
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+func TestCompose(t *testing.T) {
+	f := Compose(Compose(atoi, double), itoa)
+	s := "4"
+	if *f(&s).Unwrap() != "8" {
+		t.Error("Compose failed")
+	}
+
+	trim := func(s *string) *option.Option[string] {
+		v := strings.TrimSpace(*s)
+		if v == "" {
+			return option.None[string]()
+		}
+		return option.Some(&v)
+	}
+	length := func(s *string) *option.Option[int] {
+		n := len(*s)
+		return option.Some(&n)
+	}
+	g := ComposeOption(trim, length)
+	blank, word := " ", " ab "
+	if g(&blank).IsSome() || *g(&word).Unwrap("") != 2 {
+		t.Error("ComposeOption failed")
+	}
+}
+
+func TestCurry(t *testing.T) {
+	add := Curry(func(a, b *int) *result.Result[int] {
+		x := *a + *b
+		return result.Ok(&x)
+	})
+	one, two := 1, 2
+	if *add(&one)(&two).Unwrap() != 3 {
+		t.Error("Curry failed")
+	}
+}
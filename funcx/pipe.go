@@ -0,0 +1,45 @@
+package funcx
+
+import "github.com/yuanzicheng/go-result-and-option/result"
+
+// Pipe2 calls the 2 functions in turn, each one with the [`Ok`] value of the previous one,
+// stopping at the first [`Err`].
+func Pipe2[A any, B any, C any](v *A, f1 func(*A) *result.Result[B], f2 func(*B) *result.Result[C]) *result.Result[C] {
+	return result.AndThen(f1(v), f2)
+}
+
+// Pipe3 calls the 3 functions in turn, each one with the [`Ok`] value of the previous one,
+// stopping at the first [`Err`].
+func Pipe3[A any, B any, C any, D any](v *A, f1 func(*A) *result.Result[B], f2 func(*B) *result.Result[C], f3 func(*C) *result.Result[D]) *result.Result[D] {
+	return result.AndThen(Pipe2(v, f1, f2), f3)
+}
+
+// Pipe4 calls the 4 functions in turn, each one with the [`Ok`] value of the previous one,
+// stopping at the first [`Err`].
+func Pipe4[A any, B any, C any, D any, E any](v *A, f1 func(*A) *result.Result[B], f2 func(*B) *result.Result[C], f3 func(*C) *result.Result[D], f4 func(*D) *result.Result[E]) *result.Result[E] {
+	return result.AndThen(Pipe3(v, f1, f2, f3), f4)
+}
+
+// Pipe5 calls the 5 functions in turn, each one with the [`Ok`] value of the previous one,
+// stopping at the first [`Err`].
+func Pipe5[A any, B any, C any, D any, E any, F any](v *A, f1 func(*A) *result.Result[B], f2 func(*B) *result.Result[C], f3 func(*C) *result.Result[D], f4 func(*D) *result.Result[E], f5 func(*E) *result.Result[F]) *result.Result[F] {
+	return result.AndThen(Pipe4(v, f1, f2, f3, f4), f5)
+}
+
+// Pipe6 calls the 6 functions in turn, each one with the [`Ok`] value of the previous one,
+// stopping at the first [`Err`].
+func Pipe6[A any, B any, C any, D any, E any, F any, G any](v *A, f1 func(*A) *result.Result[B], f2 func(*B) *result.Result[C], f3 func(*C) *result.Result[D], f4 func(*D) *result.Result[E], f5 func(*E) *result.Result[F], f6 func(*F) *result.Result[G]) *result.Result[G] {
+	return result.AndThen(Pipe5(v, f1, f2, f3, f4, f5), f6)
+}
+
+// Pipe7 calls the 7 functions in turn, each one with the [`Ok`] value of the previous one,
+// stopping at the first [`Err`].
+func Pipe7[A any, B any, C any, D any, E any, F any, G any, H any](v *A, f1 func(*A) *result.Result[B], f2 func(*B) *result.Result[C], f3 func(*C) *result.Result[D], f4 func(*D) *result.Result[E], f5 func(*E) *result.Result[F], f6 func(*F) *result.Result[G], f7 func(*G) *result.Result[H]) *result.Result[H] {
+	return result.AndThen(Pipe6(v, f1, f2, f3, f4, f5, f6), f7)
+}
+
+// Pipe8 calls the 8 functions in turn, each one with the [`Ok`] value of the previous one,
+// stopping at the first [`Err`].
+func Pipe8[A any, B any, C any, D any, E any, F any, G any, H any, I any](v *A, f1 func(*A) *result.Result[B], f2 func(*B) *result.Result[C], f3 func(*C) *result.Result[D], f4 func(*D) *result.Result[E], f5 func(*E) *result.Result[F], f6 func(*F) *result.Result[G], f7 func(*G) *result.Result[H], f8 func(*H) *result.Result[I]) *result.Result[I] {
+	return result.AndThen(Pipe7(v, f1, f2, f3, f4, f5, f6, f7), f8)
+}
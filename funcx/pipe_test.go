@@ -0,0 +1,45 @@
+package funcx
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+func atoi(s *string) *result.Result[int] {
+	n, err := strconv.Atoi(*s)
+	return result.New(&n, err)
+}
+
+func double(n *int) *result.Result[int] {
+	x := *n * 2
+	return result.Ok(&x)
+}
+
+func itoa(n *int) *result.Result[string] {
+	s := strconv.Itoa(*n)
+	return result.Ok(&s)
+}
+
+func TestPipe(t *testing.T) {
+	s := "21"
+	if *Pipe3(&s, atoi, double, itoa).Unwrap() != "42" {
+		t.Error("Pipe3 failed")
+	}
+	if *Pipe8(&s, atoi, double, itoa, atoi, double, itoa, atoi, double).Unwrap() != 168 {
+		t.Error("Pipe8 failed")
+	}
+
+	bad := "x"
+	calls := 0
+	r := Pipe2(&bad, atoi, func(n *int) *result.Result[int] {
+		calls++
+		return double(n)
+	})
+	var numErr *strconv.NumError
+	if !errors.As(r.UnwrapError(), &numErr) || calls != 0 {
+		t.Error("Pipe2 failed to stop at Err")
+	}
+}
@@ -0,0 +1,63 @@
+package future
+
+import (
+	"context"
+	"sync"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// Future is a result that becomes available once an asynchronous computation completes.
+type Future[T any] struct {
+	done chan struct{}
+	res  *result.Result[T]
+}
+
+// New creates a pending future along with the function completing it.
+// Only the first call to the complete function has an effect.
+func New[T any]() (*Future[T], func(*result.Result[T])) {
+	f := &Future[T]{done: make(chan struct{})}
+	var once sync.Once
+	return f, func(r *result.Result[T]) {
+		once.Do(func() {
+			f.res = r
+			close(f.done)
+		})
+	}
+}
+
+// Go runs `f` in a goroutine and returns a future of its result.
+// A panic in `f` completes the future with an [`Err`] of `*result.PanicError`.
+func Go[T any](f func() *result.Result[T]) *Future[T] {
+	fut, complete := New[T]()
+	go func() {
+		complete(result.Catch(f))
+	}()
+	return fut
+}
+
+// Done returns a channel that is closed once the result is available.
+func (f *Future[T]) Done() <-chan struct{} {
+	return f.done
+}
+
+// Await waits for the result, or returns an [`Err`] of `ctx.Err()` if the context is done first.
+func (f *Future[T]) Await(ctx context.Context) *result.Result[T] {
+	select {
+	case <-f.done:
+		return f.res
+	case <-ctx.Done():
+		return result.Err[T](ctx.Err())
+	}
+}
+
+// Poll returns the result if available, otherwise [`None`].
+func (f *Future[T]) Poll() *option.Option[result.Result[T]] {
+	select {
+	case <-f.done:
+		return option.Some(f.res)
+	default:
+		return option.None[result.Result[T]]()
+	}
+}
@@ -0,0 +1,46 @@
+package future
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+func TestGo(t *testing.T) {
+	x := 1
+	f := Go(func() *result.Result[int] {
+		return result.Ok(&x)
+	})
+	if r := f.Await(context.Background()); *r.Unwrap() != 1 {
+		t.Error("Await failed")
+	}
+	if f.Poll().IsNone() {
+		t.Error("Poll failed")
+	}
+
+	p := Go(func() *result.Result[int] {
+		panic("boom")
+	})
+	var pe *result.PanicError
+	if !errors.As(p.Await(context.Background()).UnwrapError(), &pe) {
+		t.Error("Go failed to recover a panic")
+	}
+}
+
+func TestNew(t *testing.T) {
+	f, complete := New[int]()
+	if f.Poll().IsSome() {
+		t.Error("Poll failed")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if !errors.Is(f.Await(ctx).UnwrapError(), context.Canceled) {
+		t.Error("Await failed")
+	}
+	complete(result.Err[int](errors.New("boom")))
+	if f.Await(context.Background()).IsOk() {
+		t.Error("complete failed")
+	}
+}
@@ -1,3 +1,3 @@
 module github.com/yuanzicheng/go-result-and-option
 
-go 1.22
+go 1.23
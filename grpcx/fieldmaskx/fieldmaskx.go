@@ -0,0 +1,157 @@
+// Package fieldmaskx bridges the patch structs of options, e.g. generated by patchgen,
+// to the field masks of gRPC update APIs.
+//
+// The path of a field is its `json` tag name if any, otherwise its name in snake case.
+// The [`Some`] values of structs with option fields are nested patches, their fields
+// having paths prefixed with the path of the field, e.g. `address.city`.
+package fieldmaskx
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"unicode"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/yuanzicheng/go-result-and-option/internal/fields"
+)
+
+// ErrNotStruct is the error of patches that are not structs or pointers to structs.
+var ErrNotStruct = errors.New("fieldmaskx: patch is not a struct")
+
+// FromPatch returns the field mask of the [`Some`] fields of the patch.
+func FromPatch(patch any) (*fieldmaskpb.FieldMask, error) {
+	v, err := structOf(patch)
+	if err != nil {
+		return nil, err
+	}
+	mask := &fieldmaskpb.FieldMask{}
+	collect(v, "", &mask.Paths)
+	return mask, nil
+}
+
+func collect(v reflect.Value, prefix string, paths *[]string) {
+	t := v.Type()
+	for i := range t.NumField() {
+		name := path(t.Field(i))
+		f := v.Field(i)
+		if name == "" || !fields.IsOption(f.Type()) || isNone(f) {
+			continue
+		}
+		if inner := some(f); isPatch(inner.Type()) {
+			collect(inner, prefix+name+".", paths)
+			continue
+		}
+		*paths = append(*paths, prefix+name)
+	}
+}
+
+// ApplyMask sets the option fields of the patch pointed to by `patch` missing from the mask to [`None`],
+// so that only the masked fields are updated. It fails on paths matching no option field.
+func ApplyMask(patch any, mask *fieldmaskpb.FieldMask) error {
+	v := reflect.ValueOf(patch)
+	if v.Kind() != reflect.Pointer || v.Elem().Kind() != reflect.Struct {
+		return ErrNotStruct
+	}
+	used := make(map[string]bool, len(mask.GetPaths()))
+	keep(v.Elem(), "", mask.GetPaths(), used)
+	for _, p := range mask.GetPaths() {
+		if !used[p] {
+			return fmt.Errorf("fieldmaskx: unknown path %q", p)
+		}
+	}
+	return nil
+}
+
+func keep(v reflect.Value, prefix string, paths []string, used map[string]bool) {
+	t := v.Type()
+	for i := range t.NumField() {
+		name := path(t.Field(i))
+		f := v.Field(i)
+		if name == "" || !fields.IsOption(f.Type()) {
+			continue
+		}
+		full := prefix + name
+		if slices.Contains(paths, full) {
+			used[full] = true
+			continue
+		}
+		if isNone(f) {
+			continue
+		}
+		if inner := some(f); isPatch(inner.Type()) && hasPrefix(paths, full+".") {
+			keep(inner, full+".", paths, used)
+			continue
+		}
+		f.Set(reflect.Zero(f.Type()))
+	}
+}
+
+func structOf(patch any) (reflect.Value, error) {
+	v := reflect.ValueOf(patch)
+	if v.Kind() == reflect.Pointer && !v.IsNil() {
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return reflect.Value{}, ErrNotStruct
+	}
+	if !v.CanAddr() {
+		p := reflect.New(v.Type())
+		p.Elem().Set(v)
+		v = p.Elem()
+	}
+	return v, nil
+}
+
+func isNone(f reflect.Value) bool {
+	return fields.OptionValue(f).IsNil()
+}
+
+// some returns the addressable value of the [`Some`] option field `f`.
+func some(f reflect.Value) reflect.Value {
+	return fields.OptionValue(f).Elem()
+}
+
+// isPatch reports whether `t` is a struct with option fields.
+func isPatch(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	for i := range t.NumField() {
+		if fields.IsOption(t.Field(i).Type) {
+			return true
+		}
+	}
+	return false
+}
+
+// path returns the path of a struct field, or the empty string for unexported fields and fields tagged "-".
+func path(f reflect.StructField) string {
+	name := fields.Name(f, "json")
+	if name == "" || name != f.Name {
+		return name
+	}
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 && !unicode.IsUpper(rune(name[i-1])) {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func hasPrefix(paths []string, prefix string) bool {
+	for _, q := range paths {
+		if strings.HasPrefix(q, prefix) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,59 @@
+package fieldmaskx
+
+import (
+	"reflect"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+)
+
+type addressPatch struct {
+	City option.Option[string]
+	Zip  option.Option[string]
+}
+
+type userPatch struct {
+	DisplayName option.Option[string] `json:"name"`
+	Age         option.Option[int]
+	HomeAddress option.Option[addressPatch]
+	Email       option.Option[option.Option[string]]
+	note        option.Option[string]
+}
+
+func TestFromPatch(t *testing.T) {
+	name, city, age := "bob", "Paris", 0
+	p := userPatch{
+		DisplayName: *option.Some(&name),
+		Age:         *option.Some(&age),
+		HomeAddress: *option.Some(&addressPatch{City: *option.Some(&city)}),
+		Email:       *option.Some(option.None[string]()),
+	}
+	mask, err := FromPatch(&p)
+	if err != nil || !reflect.DeepEqual(mask.Paths, []string{"name", "age", "home_address.city", "email"}) {
+		t.Errorf("FromPatch failed: %v, %v", mask, err)
+	}
+	if _, err := FromPatch(1); err != ErrNotStruct {
+		t.Error("FromPatch failed to reject a non-struct")
+	}
+}
+
+func TestApplyMask(t *testing.T) {
+	name, city, zip, age := "bob", "Paris", "75001", 3
+	p := userPatch{
+		DisplayName: *option.Some(&name),
+		Age:         *option.Some(&age),
+		HomeAddress: *option.Some(&addressPatch{City: *option.Some(&city), Zip: *option.Some(&zip)}),
+	}
+	if err := ApplyMask(&p, &fieldmaskpb.FieldMask{Paths: []string{"age", "home_address.zip"}}); err != nil {
+		t.Fatal(err)
+	}
+	mask, _ := FromPatch(p)
+	if !reflect.DeepEqual(mask.Paths, []string{"age", "home_address.zip"}) {
+		t.Errorf("ApplyMask failed: %v", mask.Paths)
+	}
+	if err := ApplyMask(&p, &fieldmaskpb.FieldMask{Paths: []string{"unknown"}}); err == nil {
+		t.Error("ApplyMask failed to reject an unknown path")
+	}
+}
@@ -0,0 +1,118 @@
+package grpcx
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// CodeOfKind returns the gRPC code of an error kind, `codes.Unknown` for unknown kinds,
+// see errkind.GRPCCode.
+func CodeOfKind(kind errkind.Kind) codes.Code {
+	return codes.Code(errkind.GRPCCode(kind))
+}
+
+// KindOfCode returns the error kind of a gRPC code, or the empty kind for `codes.OK` and `codes.Unknown`,
+// see errkind.OfGRPCCode.
+func KindOfCode(code codes.Code) errkind.Kind {
+	return errkind.OfGRPCCode(uint32(code))
+}
+
+// domain is the domain of the `ErrorInfo` details carrying error kinds.
+const domain = "github.com/yuanzicheng/go-result-and-option"
+
+// ToStatus converts an error to a gRPC status. Errors already carrying a status keep it,
+// context errors get their matching code, and other errors get the code of their kind.
+// The kind is attached as an `ErrorInfo` detail, and the fields and retry delay of the
+// result.Details of the error as `BadRequest` and `RetryInfo` details; their value is dropped.
+func ToStatus(err error) *status.Status {
+	if err == nil {
+		return status.New(codes.OK, "")
+	}
+	if s, ok := status.FromError(err); ok {
+		return s
+	}
+	switch {
+	case errors.Is(err, context.Canceled):
+		return status.New(codes.Canceled, err.Error())
+	case errors.Is(err, context.DeadlineExceeded):
+		return status.New(codes.DeadlineExceeded, err.Error())
+	}
+	kind := errkind.KindOf(err)
+	s := status.New(CodeOfKind(kind), err.Error())
+	var details []protoadapt.MessageV1
+	if kind != "" {
+		details = append(details, &errdetails.ErrorInfo{Reason: string(kind), Domain: domain})
+	}
+	if d, ok := result.ErrorDetails(err); ok {
+		if len(d.Fields) > 0 {
+			br := &errdetails.BadRequest{}
+			for _, field := range slices.Sorted(maps.Keys(d.Fields)) {
+				br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{Field: field, Description: d.Fields[field]})
+			}
+			details = append(details, br)
+		}
+		if d.RetryAfter > 0 {
+			details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(d.RetryAfter)})
+		}
+	}
+	if ds, err := s.WithDetails(details...); err == nil {
+		return ds
+	}
+	return s
+}
+
+// FromStatus converts a gRPC status back to an error tagged with the kind of its `ErrorInfo`
+// detail, or else of its code, nil for `codes.OK`. Its `BadRequest` and `RetryInfo` details
+// are restored as result.Details, see ToStatus. The status can still be retrieved with `status.FromError`.
+func FromStatus(s *status.Status) error {
+	err := s.Err()
+	if err == nil {
+		return nil
+	}
+	kind := KindOfCode(s.Code())
+	var d result.Details
+	for _, detail := range s.Details() {
+		switch detail := detail.(type) {
+		case *errdetails.ErrorInfo:
+			if detail.GetDomain() == domain {
+				kind = errkind.Kind(detail.GetReason())
+			}
+		case *errdetails.BadRequest:
+			for _, v := range detail.GetFieldViolations() {
+				if d.Fields == nil {
+					d.Fields = map[string]string{}
+				}
+				d.Fields[v.GetField()] = v.GetDescription()
+			}
+		case *errdetails.RetryInfo:
+			d.RetryAfter = detail.GetRetryDelay().AsDuration()
+		}
+	}
+	if d.Fields != nil || d.RetryAfter > 0 {
+		err = &result.DetailedError{Details: d, Err: err}
+	}
+	if kind != "" {
+		return errkind.Wrap(kind, err)
+	}
+	return err
+}
+
+// Respond returns the value and error of the result in the form expected from gRPC methods,
+// the error being converted with ToStatus.
+func Respond[T any](r *result.Result[T]) (*T, error) {
+	if r.IsErr() {
+		return nil, ToStatus(r.UnwrapError()).Err()
+	}
+	return r.Unwrap(), nil
+}
@@ -0,0 +1,60 @@
+package grpcx
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+func TestStatus(t *testing.T) {
+	err := errkind.Wrap(errkind.NotFound, errors.New("no such user"))
+	s := ToStatus(err)
+	if s.Code() != codes.NotFound || s.Message() != "no such user" {
+		t.Error("ToStatus failed")
+	}
+	back := FromStatus(s)
+	if !errors.Is(back, errkind.NotFound) || status.Code(back) != codes.NotFound {
+		t.Error("FromStatus failed")
+	}
+	if ToStatus(context.Canceled).Code() != codes.Canceled || ToStatus(errors.New("x")).Code() != codes.Unknown {
+		t.Error("ToStatus failed")
+	}
+	if FromStatus(status.New(codes.OK, "")) != nil {
+		t.Error("FromStatus failed on OK")
+	}
+}
+
+func TestStatusDetails(t *testing.T) {
+	d := result.Details{Fields: map[string]string{"name": "required", "age": "too low"}, RetryAfter: 3 * time.Second}
+	err := errkind.Wrap(errkind.Kind("quota"), &result.DetailedError{Details: d, Err: errors.New("bad request")})
+	s := ToStatus(err)
+	if len(s.Details()) != 3 {
+		t.Fatalf("ToStatus attached %d details", len(s.Details()))
+	}
+	back := FromStatus(status.FromProto(s.Proto()))
+	if errkind.KindOf(back) != "quota" || status.Code(back) != codes.Unknown {
+		t.Errorf("FromStatus lost the kind or code: %v", back)
+	}
+	if got, ok := result.ErrorDetails(back); !ok || !maps.Equal(got.Fields, d.Fields) || got.RetryAfter != d.RetryAfter {
+		t.Errorf("FromStatus restored details %+v", got)
+	}
+}
+
+func TestRespond(t *testing.T) {
+	x := 1
+	if v, err := Respond(result.Ok(&x)); err != nil || *v != 1 {
+		t.Error("Respond failed")
+	}
+	v, err := Respond(result.Err[int](errkind.Wrap(errkind.Invalid, errors.New("bad"))))
+	if v != nil || status.Code(err) != codes.InvalidArgument {
+		t.Error("Respond failed")
+	}
+}
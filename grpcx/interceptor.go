@@ -0,0 +1,46 @@
+package grpcx
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// ErrorHook is called with the error of every failed call, e.g. for logging.
+type ErrorHook func(ctx context.Context, method string, err error)
+
+// UnaryServerInterceptor returns an interceptor converting handler panics into
+// `*result.PanicError` errors of the Internal kind, calling the hooks with every
+// error, and converting the errors returned to the client with ToStatus.
+// A panic is only reported to the client as an Internal "internal error" status,
+// so the panic value doesn't leak; the hooks get the full error.
+func UnaryServerInterceptor(hooks ...ErrorHook) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		r := result.Catch(func() *result.Result[any] {
+			resp, err := handler(ctx, req)
+			return result.New(&resp, err)
+		})
+		if r.IsOk() {
+			return *r.Unwrap(), nil
+		}
+		err := r.UnwrapError()
+		pe := (*result.PanicError)(nil)
+		panicked := errors.As(err, &pe)
+		if panicked {
+			err = errkind.Wrap(errkind.Internal, err)
+		}
+		for _, hook := range hooks {
+			hook(ctx, info.FullMethod, err)
+		}
+		if panicked {
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+		return nil, ToStatus(err).Err()
+	}
+}
@@ -0,0 +1,50 @@
+package grpcx
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+)
+
+func TestUnaryServerInterceptor(t *testing.T) {
+	var methods []string
+	var last error
+	intercept := UnaryServerInterceptor(func(ctx context.Context, method string, err error) {
+		methods = append(methods, method)
+		last = err
+	})
+	info := &grpc.UnaryServerInfo{FullMethod: "/svc/Get"}
+
+	resp, err := intercept(context.Background(), nil, info, func(ctx context.Context, req any) (any, error) {
+		return "ok", nil
+	})
+	if resp != "ok" || err != nil || len(methods) != 0 {
+		t.Error("UnaryServerInterceptor failed on success")
+	}
+
+	_, err = intercept(context.Background(), nil, info, func(ctx context.Context, req any) (any, error) {
+		return nil, errkind.Wrap(errkind.NotFound, errors.New("no such user"))
+	})
+	if status.Code(err) != codes.NotFound || len(methods) != 1 {
+		t.Error("UnaryServerInterceptor failed on error")
+	}
+
+	_, err = intercept(context.Background(), nil, info, func(ctx context.Context, req any) (any, error) {
+		panic("boom")
+	})
+	if status.Code(err) != codes.Internal || len(methods) != 2 || methods[1] != "/svc/Get" {
+		t.Error("UnaryServerInterceptor failed on panic")
+	}
+	if msg := status.Convert(err).Message(); msg != "internal error" {
+		t.Errorf("UnaryServerInterceptor leaked the panic to the client: %q", msg)
+	}
+	if last == nil || last.Error() != "panic: boom" {
+		t.Errorf("UnaryServerInterceptor hid the panic from the hooks: %v", last)
+	}
+}
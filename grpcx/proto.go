@@ -0,0 +1,73 @@
+package grpcx
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative resultpb/result.proto
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/grpcx/resultpb"
+	"github.com/yuanzicheng/go-result-and-option/msgx"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// ToProto converts a result of a protobuf message to its wire envelope, the error
+// being converted with ToStatus. An [`Ok`] of nil is encoded as an `Any` without
+// a type URL, so that it can't be mistaken for an empty envelope.
+// It fails if the value cannot be marshaled.
+func ToProto[T any, M interface {
+	*T
+	proto.Message
+}](r *result.Result[T]) *result.Result[resultpb.Result] {
+	if r.IsErr() {
+		return result.Ok(&resultpb.Result{
+			Outcome: &resultpb.Result_Error{Error: ToStatus(r.UnwrapError()).Proto()},
+		})
+	}
+	if !r.IsOkAndNotNil() {
+		return result.Ok(&resultpb.Result{Outcome: &resultpb.Result_Value{Value: &anypb.Any{}}})
+	}
+	v, err := anypb.New(M(r.Unwrap()))
+	if err != nil {
+		return result.Err[resultpb.Result](err)
+	}
+	return result.Ok(&resultpb.Result{Outcome: &resultpb.Result_Value{Value: v}})
+}
+
+// FromProto converts a wire envelope back to a result, the error being converted
+// with FromStatus. It fails with an Invalid error if the value isn't a `T`, or if
+// the envelope is nil or has no outcome, e.g. because the message was truncated.
+func FromProto[T any, M interface {
+	*T
+	proto.Message
+}](pb *resultpb.Result) *result.Result[T] {
+	switch o := pb.GetOutcome().(type) {
+	case *resultpb.Result_Error:
+		return result.Err[T](FromStatus(status.FromProto(o.Error)))
+	case *resultpb.Result_Value:
+		if o.Value.GetTypeUrl() == "" {
+			return result.Ok[T](nil)
+		}
+		v := new(T)
+		if err := o.Value.UnmarshalTo(M(v)); err != nil {
+			return result.Err[T](errkind.Wrap(errkind.Invalid, err))
+		}
+		return result.Ok(v)
+	}
+	return result.Err[T](errkind.Wrap(errkind.Invalid, errors.New("grpcx: result envelope without outcome")))
+}
+
+// ProtoCodec is the msgx codec of `application/x-protobuf` payloads, decoded into
+// values implementing `proto.Message`, e.g. `msgx.Decode[pb.Order](payload, grpcx.ProtoCodec)`.
+var ProtoCodec = msgx.NewCodec("application/x-protobuf", func(data []byte, v any) error {
+	m, ok := v.(proto.Message)
+	if !ok {
+		return fmt.Errorf("grpcx: %T is not a proto.Message", v)
+	}
+	return proto.Unmarshal(data, m)
+})
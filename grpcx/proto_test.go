@@ -0,0 +1,70 @@
+package grpcx
+
+import (
+	"errors"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/grpcx/resultpb"
+	"github.com/yuanzicheng/go-result-and-option/msgx"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+func roundTrip(t *testing.T, pb *resultpb.Result) *resultpb.Result {
+	b, err := proto.Marshal(pb)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out resultpb.Result
+	if err := proto.Unmarshal(b, &out); err != nil {
+		t.Fatal(err)
+	}
+	return &out
+}
+
+func TestProto(t *testing.T) {
+	pb := ToProto(result.Ok(wrapperspb.String("hello"))).Unwrap()
+	r := FromProto[wrapperspb.StringValue](roundTrip(t, pb))
+	if r.Unwrap().GetValue() != "hello" {
+		t.Error("Proto failed on Ok")
+	}
+
+	pb = ToProto(result.Err[wrapperspb.StringValue](errkind.Wrap(errkind.NotFound, errors.New("no such user")))).Unwrap()
+	r = FromProto[wrapperspb.StringValue](roundTrip(t, pb))
+	if !errors.Is(r.UnwrapError(), errkind.NotFound) || r.UnwrapError().Error() != "rpc error: code = NotFound desc = no such user" {
+		t.Error("Proto failed on Err")
+	}
+
+	if !errors.Is(FromProto[wrapperspb.StringValue](&resultpb.Result{}).UnwrapError(), errkind.Invalid) {
+		t.Error("FromProto failed on empty envelope")
+	}
+	if !errors.Is(FromProto[wrapperspb.StringValue](nil).UnwrapError(), errkind.Invalid) {
+		t.Error("FromProto failed on nil envelope")
+	}
+	pb = ToProto(result.Ok[wrapperspb.StringValue](nil)).Unwrap()
+	if r := FromProto[wrapperspb.StringValue](roundTrip(t, pb)); !r.IsOk() || r.Unwrap() != nil {
+		t.Error("Proto failed on Ok of nil")
+	}
+	pb = ToProto(result.Err[wrapperspb.StringValue](errkind.Wrap(errkind.NotFound, errors.New("no such user")))).Unwrap()
+	if !errors.Is(FromProto[durationpb.Duration](pb).UnwrapError(), errkind.NotFound) {
+		t.Error("FromProto failed on Err")
+	}
+	pb = ToProto(result.Ok(wrapperspb.String("hello"))).Unwrap()
+	if !errors.Is(FromProto[durationpb.Duration](pb).UnwrapError(), errkind.Invalid) {
+		t.Error("FromProto failed on mismatched type")
+	}
+}
+
+func TestProtoCodec(t *testing.T) {
+	b, _ := proto.Marshal(wrapperspb.String("a"))
+	if r := msgx.Decode[wrapperspb.StringValue](b, ProtoCodec); r.Unwrap().GetValue() != "a" {
+		t.Error("ProtoCodec failed")
+	}
+	if !msgx.IsPoison(msgx.Decode[int](b, ProtoCodec).UnwrapError()) {
+		t.Error("ProtoCodec failed to reject a non-message")
+	}
+}
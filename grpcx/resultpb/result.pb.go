@@ -0,0 +1,176 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v5.29.3
+// source: resultpb/result.proto
+
+package resultpb
+
+import (
+	status "google.golang.org/genproto/googleapis/rpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	anypb "google.golang.org/protobuf/types/known/anypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Result is the wire form of a Result: either a value or an error.
+// An Ok holding no value has an empty Any value; a Result with neither is invalid.
+type Result struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Outcome:
+	//
+	//	*Result_Value
+	//	*Result_Error
+	Outcome       isResult_Outcome `protobuf_oneof:"outcome"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Result) Reset() {
+	*x = Result{}
+	mi := &file_resultpb_result_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Result) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Result) ProtoMessage() {}
+
+func (x *Result) ProtoReflect() protoreflect.Message {
+	mi := &file_resultpb_result_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Result.ProtoReflect.Descriptor instead.
+func (*Result) Descriptor() ([]byte, []int) {
+	return file_resultpb_result_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Result) GetOutcome() isResult_Outcome {
+	if x != nil {
+		return x.Outcome
+	}
+	return nil
+}
+
+func (x *Result) GetValue() *anypb.Any {
+	if x != nil {
+		if x, ok := x.Outcome.(*Result_Value); ok {
+			return x.Value
+		}
+	}
+	return nil
+}
+
+func (x *Result) GetError() *status.Status {
+	if x != nil {
+		if x, ok := x.Outcome.(*Result_Error); ok {
+			return x.Error
+		}
+	}
+	return nil
+}
+
+type isResult_Outcome interface {
+	isResult_Outcome()
+}
+
+type Result_Value struct {
+	// The Ok value.
+	Value *anypb.Any `protobuf:"bytes,1,opt,name=value,proto3,oneof"`
+}
+
+type Result_Error struct {
+	// The Err error, with its code, message and details.
+	Error *status.Status `protobuf:"bytes,2,opt,name=error,proto3,oneof"`
+}
+
+func (*Result_Value) isResult_Outcome() {}
+
+func (*Result_Error) isResult_Outcome() {}
+
+var File_resultpb_result_proto protoreflect.FileDescriptor
+
+const file_resultpb_result_proto_rawDesc = "" +
+	"\n" +
+	"\x15resultpb/result.proto\x12\vgoresult.v1\x1a\x19google/protobuf/any.proto\x1a\x17google/rpc/status.proto\"m\n" +
+	"\x06Result\x12,\n" +
+	"\x05value\x18\x01 \x01(\v2\x14.google.protobuf.AnyH\x00R\x05value\x12*\n" +
+	"\x05error\x18\x02 \x01(\v2\x12.google.rpc.StatusH\x00R\x05errorB\t\n" +
+	"\aoutcomeB<Z:github.com/yuanzicheng/go-result-and-option/grpcx/resultpbb\x06proto3"
+
+var (
+	file_resultpb_result_proto_rawDescOnce sync.Once
+	file_resultpb_result_proto_rawDescData []byte
+)
+
+func file_resultpb_result_proto_rawDescGZIP() []byte {
+	file_resultpb_result_proto_rawDescOnce.Do(func() {
+		file_resultpb_result_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_resultpb_result_proto_rawDesc), len(file_resultpb_result_proto_rawDesc)))
+	})
+	return file_resultpb_result_proto_rawDescData
+}
+
+var file_resultpb_result_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_resultpb_result_proto_goTypes = []any{
+	(*Result)(nil),        // 0: goresult.v1.Result
+	(*anypb.Any)(nil),     // 1: google.protobuf.Any
+	(*status.Status)(nil), // 2: google.rpc.Status
+}
+var file_resultpb_result_proto_depIdxs = []int32{
+	1, // 0: goresult.v1.Result.value:type_name -> google.protobuf.Any
+	2, // 1: goresult.v1.Result.error:type_name -> google.rpc.Status
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_resultpb_result_proto_init() }
+func file_resultpb_result_proto_init() {
+	if File_resultpb_result_proto != nil {
+		return
+	}
+	file_resultpb_result_proto_msgTypes[0].OneofWrappers = []any{
+		(*Result_Value)(nil),
+		(*Result_Error)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_resultpb_result_proto_rawDesc), len(file_resultpb_result_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_resultpb_result_proto_goTypes,
+		DependencyIndexes: file_resultpb_result_proto_depIdxs,
+		MessageInfos:      file_resultpb_result_proto_msgTypes,
+	}.Build()
+	File_resultpb_result_proto = out.File
+	file_resultpb_result_proto_goTypes = nil
+	file_resultpb_result_proto_depIdxs = nil
+}
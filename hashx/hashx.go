@@ -0,0 +1,189 @@
+// Package hashx computes deterministic hashes of options and results, e.g. for cache keys
+// and consistent hashing.
+package hashx
+
+import (
+	"encoding"
+	"encoding/binary"
+	"hash/fnv"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/yuanzicheng/go-result-and-option/internal/fields"
+	"github.com/yuanzicheng/go-result-and-option/internal/peek"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// Hasher is implemented by values computing their own hash, used in place of their encoding.
+type Hasher interface {
+	Hash64() uint64
+}
+
+var resultPkgPath = reflect.TypeFor[result.Result[int]]().PkgPath()
+
+// Hash64 returns a deterministic hash of a value, typically an option or a result.
+// Values are hashed through their Hasher implementation if any. Otherwise:
+//   - a [`None`] and a [`Some`] hash differently, the latter by its value;
+//   - an [`Err`] hashes by its error message, an [`Ok`] by its value, the zero value for nil;
+//   - other values hash by their `encoding.BinaryMarshaler` implementation, their bytes for strings,
+//     their `binary.Append` encoding for fixed-size types, or else by their structure: structs by
+//     all their fields, slices and arrays by their elements, maps by their entries in any order.
+//
+// Pointers and interfaces hash like the values they point to. Funcs, channels and unsafe pointers
+// can't be hashed deterministically, so Hash64 panics on them unless a Hasher handles them.
+func Hash64(v any) uint64 {
+	var h hasher
+	return h.hash(reflect.ValueOf(v))
+}
+
+// hasher keeps the pointers on the path from the root, so that cycles terminate.
+type hasher struct {
+	path map[uintptr]bool
+}
+
+func (h *hasher) hash(v reflect.Value) uint64 {
+	if !v.IsValid() {
+		return sum(nil)
+	}
+	if v.Kind() == reflect.Pointer && !isWrapper(v.Type().Elem()) {
+		if v.IsNil() {
+			return sum(nil)
+		}
+		return h.elem(v)
+	}
+	// Values read from unexported fields can't be converted to interfaces, they are hashed by their structure.
+	if v.CanInterface() {
+		if x, ok := v.Interface().(Hasher); ok {
+			return x.Hash64()
+		}
+		if t := v.Type(); isWrapper(t) {
+			p := reflect.New(t)
+			p.Elem().Set(v)
+			v = p
+		}
+		if v.Kind() == reflect.Pointer && isWrapper(v.Type().Elem()) {
+			return h.hashWrapper(v)
+		}
+		if m, ok := v.Interface().(encoding.BinaryMarshaler); ok {
+			if b, err := m.MarshalBinary(); err == nil {
+				return sum(b)
+			}
+		}
+		if b, err := binary.Append(nil, binary.BigEndian, v.Interface()); err == nil {
+			return sum(b)
+		}
+	}
+	return h.hashStructure(v)
+}
+
+// elem hashes the value pointed to by the non-nil pointer `v`,
+// a pointer already on the path from the root hashing as a cycle marker.
+func (h *hasher) elem(v reflect.Value) uint64 {
+	p := v.Pointer()
+	if h.path[p] {
+		return sum([]byte("<cycle>"))
+	}
+	if h.path == nil {
+		h.path = make(map[uintptr]bool)
+	}
+	h.path[p] = true
+	defer delete(h.path, p)
+	return h.hash(v.Elem())
+}
+
+// hashStructure hashes a value by its kind and its content, never by an address.
+func (h *hasher) hashStructure(v reflect.Value) uint64 {
+	switch v.Kind() {
+	case reflect.String:
+		return sum([]byte(v.String()))
+	case reflect.Bool:
+		return sum(strconv.AppendBool(nil, v.Bool()))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return sum(strconv.AppendInt(nil, v.Int(), 10))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return sum(strconv.AppendUint(nil, v.Uint(), 10))
+	case reflect.Float32, reflect.Float64:
+		return sum(strconv.AppendFloat(nil, v.Float(), 'g', -1, 64))
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		return sum(strconv.AppendFloat(strconv.AppendFloat(nil, real(c), 'g', -1, 64), imag(c), 'g', -1, 64))
+	case reflect.Interface:
+		if v.IsNil() {
+			return sum(nil)
+		}
+		return h.hash(v.Elem())
+	case reflect.Pointer:
+		if v.IsNil() {
+			return sum(nil)
+		}
+		return h.elem(v)
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.Type().Elem().Kind() == reflect.Uint8 {
+			return sum(v.Bytes())
+		}
+		b := binary.BigEndian.AppendUint64(nil, uint64(v.Len()))
+		for i := range v.Len() {
+			b = binary.BigEndian.AppendUint64(b, h.hash(v.Index(i)))
+		}
+		return sum(b)
+	case reflect.Struct:
+		b := []byte(v.Type().String())
+		for i := range v.NumField() {
+			b = binary.BigEndian.AppendUint64(b, h.hash(v.Field(i)))
+		}
+		return sum(b)
+	case reflect.Map:
+		entries := make([]uint64, 0, v.Len())
+		for it := v.MapRange(); it.Next(); {
+			kv := binary.BigEndian.AppendUint64(nil, h.hash(it.Key()))
+			entries = append(entries, sum(binary.BigEndian.AppendUint64(kv, h.hash(it.Value()))))
+		}
+		slices.Sort(entries)
+		b := binary.BigEndian.AppendUint64(nil, uint64(len(entries)))
+		for _, e := range entries {
+			b = binary.BigEndian.AppendUint64(b, e)
+		}
+		return sum(b)
+	}
+	panic("hashx: can't hash a " + v.Kind().String() + " deterministically, implement Hasher")
+}
+
+// hashWrapper hashes a pointer to an option or a result.
+func (h *hasher) hashWrapper(p reflect.Value) uint64 {
+	if p.IsNil() {
+		return sum(nil)
+	}
+	if fields.IsOption(p.Type().Elem()) {
+		v := reflect.ValueOf(peek.Option(p.Interface()))
+		if v.IsNil() {
+			return sum([]byte{0})
+		}
+		return combine(1, h.hash(v))
+	}
+	value, err := peek.Result(p.Interface())
+	if err != nil {
+		return combine(2, sum([]byte(err.Error())))
+	}
+	v := reflect.ValueOf(value)
+	if v.IsNil() {
+		v = reflect.New(v.Type().Elem())
+	}
+	return combine(3, h.hash(v))
+}
+
+func isWrapper(t reflect.Type) bool {
+	return fields.IsOption(t) || t.PkgPath() == resultPkgPath && strings.HasPrefix(t.Name(), "Result[")
+}
+
+func sum(b []byte) uint64 {
+	h := fnv.New64a()
+	h.Write(b)
+	return h.Sum64()
+}
+
+// combine hashes a tag byte followed by a hash.
+func combine(tag byte, h uint64) uint64 {
+	return sum(binary.BigEndian.AppendUint64([]byte{tag}, h))
+}
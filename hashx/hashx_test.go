@@ -0,0 +1,83 @@
+package hashx
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+type id string
+
+func (id) Hash64() uint64 { return 7 }
+
+func TestHash64(t *testing.T) {
+	a, b := "a", "a"
+	if Hash64(option.Some(&a)) != Hash64(option.Some(&b)) || Hash64(option.Some(&a)) != Hash64(*option.Some(&b)) {
+		t.Error("Hash64 isn't deterministic")
+	}
+	if Hash64(option.Some(&a)) == Hash64(option.None[string]()) || Hash64(option.None[string]()) != Hash64(option.None[int]()) {
+		t.Error("Hash64 failed on None")
+	}
+	x, y := 1, 2
+	if Hash64(result.Ok(&x)) == Hash64(result.Ok(&y)) || Hash64(result.Ok(&x)) == Hash64(option.Some(&x)) {
+		t.Error("Hash64 collided")
+	}
+	if Hash64(result.Err[int](errors.New("boom"))) != Hash64(result.Err[string](errors.New("boom"))) {
+		t.Error("Hash64 failed on Err")
+	}
+	zero := 0
+	if Hash64(result.Ok[int](nil)) != Hash64(result.Ok(&zero)) {
+		t.Error("Hash64 failed on a nil Ok")
+	}
+	i := id("x")
+	if Hash64(i) != 7 || Hash64(option.Some(&i)) != combine(1, 7) {
+		t.Error("Hash64 failed to delegate to Hasher")
+	}
+	m := map[string]int{"a": 1, "b": 2}
+	if Hash64(option.Some(&m)) != Hash64(option.Some(&map[string]int{"b": 2, "a": 1})) {
+		t.Error("Hash64 failed on a map")
+	}
+}
+
+type withPointers struct {
+	Name  string
+	Next  *withPointers
+	Tags  []string
+	attrs map[string]*int
+}
+
+func TestHash64Structure(t *testing.T) {
+	one, alsoOne, two := 1, 1, 2
+	a := withPointers{Name: "a", Next: &withPointers{Name: "b"}, Tags: []string{"x"}, attrs: map[string]*int{"n": &one}}
+	b := withPointers{Name: "a", Next: &withPointers{Name: "b"}, Tags: []string{"x"}, attrs: map[string]*int{"n": &alsoOne}}
+	if Hash64(option.Some(&a)) != Hash64(option.Some(&b)) {
+		t.Error("Hash64 depends on pointer addresses")
+	}
+	b.attrs["n"] = &two
+	if Hash64(a) == Hash64(b) {
+		t.Error("Hash64 ignored an unexported field")
+	}
+	b.attrs["n"], b.Next.Name = &one, "c"
+	if Hash64(a) == Hash64(b) {
+		t.Error("Hash64 ignored a pointed to value")
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("Hash64 hashed a func")
+		}
+	}()
+	Hash64(struct{ F func() }{})
+}
+
+func TestHash64Cycle(t *testing.T) {
+	a := &withPointers{Name: "a"}
+	a.Next = a
+	b := &withPointers{Name: "a"}
+	b.Next = b
+	if Hash64(a) != Hash64(b) {
+		t.Error("Hash64 failed on a cycle")
+	}
+}
@@ -0,0 +1,181 @@
+package hooks
+
+import (
+	"math/rand/v2"
+	"slices"
+	"sync"
+	"sync/atomic"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+type config struct {
+	kinds  []errkind.Kind
+	events []result.Event
+	rate   float64
+}
+
+// Opt filters the events passed to a subscriber.
+type Opt func(*config)
+
+// WithKinds only passes errors of the kinds, see errkind.KindOf.
+// Errors without a kind match the empty kind. It doesn't apply to None subscribers.
+func WithKinds(kinds ...errkind.Kind) Opt {
+	return func(c *config) {
+		c.kinds = kinds
+	}
+}
+
+// WithEvents only passes the events, e.g. `result.Inspected`. It doesn't apply to None subscribers.
+func WithEvents(events ...result.Event) Opt {
+	return func(c *config) {
+		c.events = events
+	}
+}
+
+// WithSampleRate passes events with the probability `rate`, between 0 and 1.
+func WithSampleRate(rate float64) Opt {
+	return func(c *config) {
+		c.rate = rate
+	}
+}
+
+func (c *config) sampled() bool {
+	return c.rate >= 1 || rand.Float64() < c.rate
+}
+
+type errSubscriber struct {
+	config
+	hook func(error, result.Meta)
+}
+
+type noneSubscriber struct {
+	config
+	hook func(option.Meta)
+}
+
+var (
+	mu       sync.Mutex
+	errSubs  atomic.Pointer[[]*errSubscriber]
+	noneSubs atomic.Pointer[[]*noneSubscriber]
+)
+
+func newConfig(opts []Opt) config {
+	c := config{rate: 1}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+// Subscribe adds a subscriber to the events of `result.SetErrHook`, returning a
+// function removing it. The registry installs itself as the error hook while it has
+// subscribers, replacing any hook set with `result.SetErrHook`.
+func Subscribe(hook func(err error, meta result.Meta), opts ...Opt) (unsubscribe func()) {
+	s := &errSubscriber{config: newConfig(opts), hook: hook}
+	update(&errSubs, func(subs []*errSubscriber) []*errSubscriber { return append(subs, s) }, setErrHook)
+	return sync.OnceFunc(func() {
+		update(&errSubs, func(subs []*errSubscriber) []*errSubscriber {
+			return slices.DeleteFunc(subs, func(x *errSubscriber) bool { return x == s })
+		}, setErrHook)
+	})
+}
+
+// SubscribeNone adds a subscriber to the events of `option.SetNoneHook`, like Subscribe.
+func SubscribeNone(hook func(meta option.Meta), opts ...Opt) (unsubscribe func()) {
+	s := &noneSubscriber{config: newConfig(opts), hook: hook}
+	update(&noneSubs, func(subs []*noneSubscriber) []*noneSubscriber { return append(subs, s) }, setNoneHook)
+	return sync.OnceFunc(func() {
+		update(&noneSubs, func(subs []*noneSubscriber) []*noneSubscriber {
+			return slices.DeleteFunc(subs, func(x *noneSubscriber) bool { return x == s })
+		}, setNoneHook)
+	})
+}
+
+func setErrHook(subscribed, empty bool) {
+	switch {
+	case subscribed:
+		result.SetErrHook(dispatchErr)
+	case empty:
+		result.SetErrHook(nil)
+	}
+}
+
+func setNoneHook(subscribed, empty bool) {
+	switch {
+	case subscribed:
+		option.SetNoneHook(dispatchNone)
+	case empty:
+		option.SetNoneHook(nil)
+	}
+}
+
+// Cleaner is implemented by `*testing.T` and `*testing.B`.
+type Cleaner interface {
+	Cleanup(func())
+}
+
+// Install subscribes the hook for the duration of a test, see Subscribe.
+func Install(t Cleaner, hook func(err error, meta result.Meta), opts ...Opt) {
+	t.Cleanup(Subscribe(hook, opts...))
+}
+
+// InstallNone subscribes the hook for the duration of a test, see SubscribeNone.
+func InstallNone(t Cleaner, hook func(meta option.Meta), opts ...Opt) {
+	t.Cleanup(SubscribeNone(hook, opts...))
+}
+
+// update replaces the subscribers by a modified copy, then calls `setHook` in the same
+// critical section, telling whether the list grew and whether it is empty, so that the
+// hook is installed and uninstalled in the order of the changes.
+func update[S any](p *atomic.Pointer[[]S], f func([]S) []S, setHook func(subscribed, empty bool)) {
+	mu.Lock()
+	defer mu.Unlock()
+	var subs []S
+	if old := p.Load(); old != nil {
+		subs = slices.Clone(*old)
+	}
+	n := len(subs)
+	subs = f(subs)
+	p.Store(&subs)
+	setHook(len(subs) > n, len(subs) == 0)
+}
+
+func dispatchErr(err error, meta result.Meta) {
+	subs := errSubs.Load()
+	if subs == nil {
+		return
+	}
+	var kind errkind.Kind
+	kindKnown := false
+	for _, s := range *subs {
+		if len(s.events) > 0 && !slices.Contains(s.events, meta.Event) {
+			continue
+		}
+		if len(s.kinds) > 0 {
+			if !kindKnown {
+				kind, kindKnown = errkind.KindOf(err), true
+			}
+			if !slices.Contains(s.kinds, kind) {
+				continue
+			}
+		}
+		if s.sampled() {
+			s.hook(err, meta)
+		}
+	}
+}
+
+func dispatchNone(meta option.Meta) {
+	subs := noneSubs.Load()
+	if subs == nil {
+		return
+	}
+	for _, s := range *subs {
+		if s.sampled() {
+			s.hook(meta)
+		}
+	}
+}
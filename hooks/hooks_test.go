@@ -0,0 +1,69 @@
+package hooks
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+func TestSubscribe(t *testing.T) {
+	var all, notFound, inspected, sampled int
+	unsubscribe := Subscribe(func(err error, meta result.Meta) { all++ })
+	Install(t, func(err error, meta result.Meta) { notFound++ }, WithKinds(errkind.NotFound))
+	Install(t, func(err error, meta result.Meta) { inspected++ }, WithEvents(result.Inspected))
+	Install(t, func(err error, meta result.Meta) { sampled++ }, WithSampleRate(0))
+
+	result.Err[int](errkind.Wrap(errkind.NotFound, errors.New("no such user"))).UnwrapError()
+	result.Err[int](errors.New("boom"))
+	if all != 3 || notFound != 2 || inspected != 1 || sampled != 0 {
+		t.Errorf("Subscribe failed: %d %d %d %d", all, notFound, inspected, sampled)
+	}
+
+	unsubscribe()
+	unsubscribe()
+	result.Err[int](errors.New("boom"))
+	if all != 3 || notFound != 2 {
+		t.Error("unsubscribe failed")
+	}
+}
+
+func TestSubscribeNone(t *testing.T) {
+	var n int
+	t.Run("installed", func(t *testing.T) {
+		InstallNone(t, func(meta option.Meta) { n++ })
+		option.None[int]()
+	})
+	option.None[int]()
+	if n != 1 {
+		t.Errorf("InstallNone failed: %d", n)
+	}
+}
+
+func TestSubscribeConcurrentUnsubscribe(t *testing.T) {
+	for range 200 {
+		unsubscribe := Subscribe(func(error, result.Meta) {})
+		var fired atomic.Int32
+		var wg sync.WaitGroup
+		var keep func()
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			unsubscribe()
+		}()
+		go func() {
+			defer wg.Done()
+			keep = Subscribe(func(error, result.Meta) { fired.Add(1) })
+		}()
+		wg.Wait()
+		result.Err[int](errors.New("boom"))
+		keep()
+		if fired.Load() != 1 {
+			t.Fatal("a subscriber was left without the hook installed")
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package httpx
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/jsonx"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// maxErrorBody bounds the response body kept in a StatusError.
+const maxErrorBody = 4 << 10
+
+// StatusError is the error of a response with a 4xx or 5xx status code.
+type StatusError struct {
+	StatusCode int
+	Status     string
+	// Body holds the beginning of the response body.
+	Body []byte
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("http status %s", e.Status)
+}
+
+// KindOfStatus returns the error kind of a 4xx or 5xx status code, or the empty kind otherwise,
+// see errkind.OfHTTPStatus.
+func KindOfStatus(code int) errkind.Kind {
+	return errkind.OfHTTPStatus(code)
+}
+
+// Do sends the request with the client, `http.DefaultClient` if nil.
+// A response with a 4xx or 5xx status code is closed and returned as an [`Err`]
+// of `*StatusError` tagged with the error kind of the status.
+func Do(ctx context.Context, client *http.Client, req *http.Request) *result.Result[http.Response] {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return result.Err[http.Response](err)
+	}
+	if resp.StatusCode >= 400 {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		err := &StatusError{StatusCode: resp.StatusCode, Status: resp.Status, Body: body}
+		return result.Err[http.Response](errkind.Wrap(KindOfStatus(resp.StatusCode), err))
+	}
+	return result.Ok(resp)
+}
+
+// Get issues a GET request to the URL, see Do.
+func Get(ctx context.Context, client *http.Client, url string) *result.Result[http.Response] {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return result.Err[http.Response](err)
+	}
+	return Do(ctx, client, req)
+}
+
+// DecodeJSON decodes the JSON body of the response into a `T` and closes the body.
+func DecodeJSON[T any](resp *http.Response) *result.Result[T] {
+	defer resp.Body.Close()
+	return jsonx.DecodeReader[T](resp.Body)
+}
@@ -0,0 +1,45 @@
+package httpx
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+type user struct {
+	Name string `json:"name"`
+}
+
+func TestClient(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/users/1" {
+			http.Error(w, "no such user", http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"name":"alice"}`))
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	u := result.AndThen(Get(ctx, nil, srv.URL+"/users/1"), DecodeJSON[user])
+	if u.Unwrap().Name != "alice" {
+		t.Error("Get failed")
+	}
+
+	err := Get(ctx, srv.Client(), srv.URL+"/users/2").UnwrapError()
+	var se *StatusError
+	if !errors.Is(err, errkind.NotFound) || !errors.As(err, &se) || string(se.Body) != "no such user\n" {
+		t.Error("Get failed to map the status")
+	}
+}
+
+func TestKindOfStatus(t *testing.T) {
+	if KindOfStatus(200) != "" || KindOfStatus(422) != errkind.Invalid || KindOfStatus(503) != errkind.Unavailable {
+		t.Error("KindOfStatus failed")
+	}
+}
@@ -0,0 +1,53 @@
+package httpx
+
+import (
+	"net/http"
+	"runtime/debug"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// ErrorHook is called with the error of every failed request, e.g. for logging.
+type ErrorHook func(r *http.Request, err error)
+
+// Intercept returns a HandlerFunc calling `f`, converting its panics into an [`Err`]
+// of `*result.PanicError` and calling the hooks when the result is an [`Err`].
+func Intercept(f HandlerFunc, hooks ...ErrorHook) HandlerFunc {
+	return func(r *http.Request) *result.Result[Response] {
+		res := result.Catch(func() *result.Result[Response] { return f(r) })
+		if res.IsErr() {
+			for _, hook := range hooks {
+				hook(r, res.UnwrapError())
+			}
+		}
+		return res
+	}
+}
+
+// Recover returns a middleware for plain handlers, converting their panics into a
+// `*result.PanicError` which is passed to the hooks and written by `render`,
+// or by RenderText if it is nil. `http.ErrAbortHandler` panics are propagated.
+func Recover(render ErrorRenderer, hooks ...ErrorHook) func(http.Handler) http.Handler {
+	if render == nil {
+		render = RenderText
+	}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer func() {
+				v := recover()
+				if v == nil {
+					return
+				}
+				if v == http.ErrAbortHandler {
+					panic(v)
+				}
+				err := &result.PanicError{Value: v, Stack: debug.Stack()}
+				for _, hook := range hooks {
+					hook(r, err)
+				}
+				render(w, r, err)
+			}()
+			next.ServeHTTP(w, r)
+		})
+	}
+}
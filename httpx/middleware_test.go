@@ -0,0 +1,44 @@
+package httpx
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+func TestIntercept(t *testing.T) {
+	var hooked []error
+	hook := func(r *http.Request, err error) { hooked = append(hooked, err) }
+
+	ok := Intercept(func(r *http.Request) *result.Result[Response] {
+		return result.Ok(&Response{})
+	}, hook)
+	if ok(httptest.NewRequest(http.MethodGet, "/", nil)).IsErr() || len(hooked) != 0 {
+		t.Error("Intercept failed on Ok")
+	}
+
+	h := Handler(Intercept(func(r *http.Request) *result.Result[Response] {
+		panic("boom")
+	}, hook))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	var pe *result.PanicError
+	if w.Code != http.StatusInternalServerError || len(hooked) != 1 || !errors.As(hooked[0], &pe) {
+		t.Error("Intercept failed on panic")
+	}
+}
+
+func TestRecover(t *testing.T) {
+	var hooked error
+	h := Recover(nil, func(r *http.Request, err error) { hooked = err })(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		panic(errors.New("boom"))
+	}))
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	if w.Code != http.StatusInternalServerError || hooked == nil || hooked.Error() != "panic: boom" {
+		t.Error("Recover failed")
+	}
+}
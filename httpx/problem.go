@@ -0,0 +1,94 @@
+package httpx
+
+import (
+	"encoding/json"
+	"maps"
+	"net/http"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// ProblemDetails is an RFC 7807 problem document.
+type ProblemDetails struct {
+	Type     string
+	Title    string
+	Status   int
+	Detail   string
+	Instance string
+	// Extensions are additional members of the document.
+	Extensions map[string]any
+}
+
+// MarshalJSON encodes the problem as a flat JSON object, omitting empty members.
+func (p ProblemDetails) MarshalJSON() ([]byte, error) {
+	m := make(map[string]any, len(p.Extensions)+5)
+	maps.Copy(m, p.Extensions)
+	for k, v := range map[string]string{"type": p.Type, "title": p.Title, "detail": p.Detail, "instance": p.Instance} {
+		if v != "" {
+			m[k] = v
+		}
+	}
+	if p.Status != 0 {
+		m["status"] = p.Status
+	}
+	return json.Marshal(m)
+}
+
+// ProblemOf returns the problem document of an error. The status comes from
+// the error kind, which is also exposed as the "kind" extension, and the detail
+// is the error message for 4xx statuses only, so internal failures aren't leaked to clients.
+// The details of the error, see result.ErrorDetails, are exposed as the "fields", "value"
+// (4xx statuses only) and "retry_after" extensions.
+func ProblemOf(err error) *ProblemDetails {
+	kind := errkind.KindOf(err)
+	code := StatusOfKind(kind)
+	p := &ProblemDetails{
+		Type:   "about:blank",
+		Title:  http.StatusText(code),
+		Status: code,
+	}
+	p.Extensions = map[string]any{}
+	if code < 500 {
+		p.Detail = err.Error()
+	}
+	if kind != "" {
+		p.Extensions["kind"] = string(kind)
+	}
+	if d, ok := result.ErrorDetails(err); ok {
+		if len(d.Fields) > 0 {
+			p.Extensions["fields"] = d.Fields
+		}
+		if d.Value != nil && code < 500 {
+			p.Extensions["value"] = d.Value
+		}
+		if d.RetryAfter > 0 {
+			p.Extensions["retry_after"] = retryAfter(d.RetryAfter)
+		}
+	}
+	if len(p.Extensions) == 0 {
+		p.Extensions = nil
+	}
+	return p
+}
+
+// Problem returns the problem document of an [`Err`], or [`None`] for an [`Ok`].
+func Problem[T any](r *result.Result[T]) *option.Option[ProblemDetails] {
+	if r.IsOk() {
+		return option.None[ProblemDetails]()
+	}
+	return option.Some(ProblemOf(r.UnwrapError()))
+}
+
+// RenderProblem is an ErrorRenderer writing the problem document of the error
+// as `application/problem+json`.
+func RenderProblem(w http.ResponseWriter, r *http.Request, err error) {
+	p := ProblemOf(err)
+	p.Instance = r.URL.Path
+	setRetryAfter(w, err)
+	body, _ := json.Marshal(p)
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(p.Status)
+	w.Write(body)
+}
@@ -0,0 +1,68 @@
+package httpx
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+func TestProblem(t *testing.T) {
+	x := 1
+	if Problem(result.Ok(&x)).IsSome() {
+		t.Error("Problem failed on Ok")
+	}
+	p := Problem(result.Err[int](errkind.Wrap(errkind.Invalid, errors.New("bad name")))).Unwrap("")
+	if p.Status != http.StatusBadRequest || p.Detail != "bad name" || p.Extensions["kind"] != "invalid" {
+		t.Error("Problem failed")
+	}
+	if ProblemOf(errors.New("secret")).Detail != "" {
+		t.Error("ProblemOf leaked an internal error")
+	}
+}
+
+func TestRenderProblem(t *testing.T) {
+	h := &Adapter{
+		Func: func(r *http.Request) *result.Result[Response] {
+			return result.Err[Response](errkind.Wrap(errkind.NotFound, errors.New("no such user")))
+		},
+		RenderErr: RenderProblem,
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/users/1", nil))
+
+	var doc map[string]any
+	json.Unmarshal(w.Body.Bytes(), &doc)
+	if w.Code != http.StatusNotFound || w.Header().Get("Content-Type") != "application/problem+json" {
+		t.Error("RenderProblem failed")
+	}
+	if doc["status"] != 404.0 || doc["detail"] != "no such user" || doc["instance"] != "/users/1" || doc["kind"] != "not_found" {
+		t.Errorf("RenderProblem failed: %v", doc)
+	}
+}
+
+func TestRenderProblemDetails(t *testing.T) {
+	err := result.Err[int](errkind.Wrap(errkind.Invalid, errors.New("bad user"))).
+		WithDetails(result.Details{Fields: map[string]string{"email": "required"}, Value: "x", RetryAfter: 1500 * time.Millisecond}).
+		UnwrapError()
+	w := httptest.NewRecorder()
+	RenderProblem(w, httptest.NewRequest(http.MethodPost, "/users", nil), err)
+
+	var doc map[string]any
+	json.Unmarshal(w.Body.Bytes(), &doc)
+	fields, _ := doc["fields"].(map[string]any)
+	if fields["email"] != "required" || doc["value"] != "x" || doc["retry_after"] != 2.0 {
+		t.Errorf("RenderProblem failed to include the details: %v", doc)
+	}
+	if w.Header().Get("Retry-After") != "2" {
+		t.Error("RenderProblem failed to set Retry-After")
+	}
+	if ProblemOf(result.Err[int](errors.New("secret")).WithDetails(result.Details{Value: "x"}).UnwrapError()).Extensions["value"] != nil {
+		t.Error("ProblemOf leaked the value of an internal error")
+	}
+}
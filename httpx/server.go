@@ -0,0 +1,107 @@
+package httpx
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// Response is written by a Handler for an [`Ok`] result.
+type Response struct {
+	// StatusCode defaults to 200.
+	StatusCode int
+	Header     http.Header
+	// Body is encoded as JSON, nothing is written if it is nil.
+	Body any
+}
+
+// HandlerFunc handles a request by returning a result.
+type HandlerFunc func(*http.Request) *result.Result[Response]
+
+// ErrorRenderer writes the response of an [`Err`] result.
+type ErrorRenderer func(w http.ResponseWriter, r *http.Request, err error)
+
+// StatusOfKind returns the status code of an error kind, 500 for unknown kinds,
+// see errkind.HTTPStatus.
+func StatusOfKind(kind errkind.Kind) int {
+	return errkind.HTTPStatus(kind)
+}
+
+// RenderText writes the status code of the error kind, with the error message as
+// a plain text body for 4xx codes and the status text for 5xx codes,
+// so internal failures aren't leaked to clients. The Retry-After header is set
+// from the details of the error, see result.ErrorDetails.
+func RenderText(w http.ResponseWriter, r *http.Request, err error) {
+	code := StatusOfKind(errkind.KindOf(err))
+	msg := http.StatusText(code)
+	if code < 500 {
+		msg = err.Error()
+	}
+	setRetryAfter(w, err)
+	http.Error(w, msg, code)
+}
+
+// retryAfter returns the seconds of a Retry-After delay, rounded up.
+func retryAfter(d time.Duration) int {
+	return int((d + time.Second - 1) / time.Second)
+}
+
+// setRetryAfter sets the Retry-After header from the details of the error, if any.
+func setRetryAfter(w http.ResponseWriter, err error) {
+	if d, ok := result.ErrorDetails(err); ok && d.RetryAfter > 0 {
+		w.Header().Set("Retry-After", strconv.Itoa(retryAfter(d.RetryAfter)))
+	}
+}
+
+// Adapter serves HTTP requests with a HandlerFunc.
+type Adapter struct {
+	Func HandlerFunc
+	// RenderErr defaults to RenderText.
+	RenderErr ErrorRenderer
+}
+
+// Handler returns an http.Handler calling `f` and writing its result,
+// errors being rendered by RenderText.
+func Handler(f HandlerFunc) http.Handler {
+	return &Adapter{Func: f}
+}
+
+func (a *Adapter) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	res := a.Func(r)
+	if res.IsErr() {
+		render := a.RenderErr
+		if render == nil {
+			render = RenderText
+		}
+		render(w, r, res.UnwrapError())
+		return
+	}
+
+	// An Ok of nil is an empty response.
+	resp := res.UnwrapOrDefault()
+	if resp == nil {
+		resp = &Response{}
+	}
+	var body []byte
+	if resp.Body != nil {
+		var err error
+		if body, err = json.Marshal(resp.Body); err != nil {
+			RenderText(w, r, err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+	}
+	for k, vs := range resp.Header {
+		w.Header()[k] = vs
+	}
+	code := resp.StatusCode
+	if code == 0 {
+		code = http.StatusOK
+	}
+	w.WriteHeader(code)
+	w.Write(body)
+}
@@ -0,0 +1,67 @@
+package httpx
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+func TestHandler(t *testing.T) {
+	h := Handler(func(r *http.Request) *result.Result[Response] {
+		switch r.URL.Path {
+		case "/ok":
+			return result.Ok(&Response{StatusCode: http.StatusCreated, Body: user{Name: "alice"}})
+		case "/missing":
+			return result.Err[Response](errkind.Wrap(errkind.NotFound, errors.New("no such user")))
+		}
+		return result.Err[Response](errors.New("database password is hunter2"))
+	})
+
+	cases := []struct {
+		path string
+		code int
+		body string
+	}{
+		{"/ok", http.StatusCreated, `{"name":"alice"}`},
+		{"/missing", http.StatusNotFound, "no such user\n"},
+		{"/boom", http.StatusInternalServerError, "Internal Server Error\n"},
+	}
+	for _, c := range cases {
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, c.path, nil))
+		if w.Code != c.code || w.Body.String() != c.body {
+			t.Errorf("%s: got %d %q", c.path, w.Code, w.Body.String())
+		}
+	}
+}
+
+func TestAdapter(t *testing.T) {
+	h := &Adapter{
+		Func: func(r *http.Request) *result.Result[Response] {
+			return result.Err[Response](errkind.Conflict)
+		},
+		RenderErr: func(w http.ResponseWriter, r *http.Request, err error) {
+			w.WriteHeader(StatusOfKind(errkind.KindOf(err)))
+		},
+	}
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	if w.Code != http.StatusConflict {
+		t.Error("RenderErr failed")
+	}
+}
+
+func TestAdapterOkNil(t *testing.T) {
+	h := Handler(func(r *http.Request) *result.Result[Response] {
+		return result.Ok[Response](nil)
+	})
+	w := httptest.NewRecorder()
+	h.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/", nil))
+	if w.Code != http.StatusOK || w.Body.Len() != 0 {
+		t.Errorf("Ok(nil) was served as %d %q", w.Code, w.Body.String())
+	}
+}
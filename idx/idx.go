@@ -0,0 +1,75 @@
+package idx
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// ErrInvalidID is returned by the parsers of this package for malformed identifiers.
+var ErrInvalidID = errors.New("invalid identifier")
+
+// Parse parses an identifier with `parser`, tagging failures with `errkind.Invalid`.
+func Parse[T any](s string, parser func(string) (T, error)) *result.Result[T] {
+	v, err := parser(s)
+	if err != nil {
+		return result.Err[T](errkind.Wrap(errkind.Invalid, err))
+	}
+	return result.Ok(&v)
+}
+
+// UUID is a universally unique identifier.
+type UUID [16]byte
+
+// String returns the canonical text form of the UUID.
+func (u UUID) String() string {
+	var buf [36]byte
+	hex.Encode(buf[0:8], u[0:4])
+	buf[8] = '-'
+	hex.Encode(buf[9:13], u[4:6])
+	buf[13] = '-'
+	hex.Encode(buf[14:18], u[6:8])
+	buf[18] = '-'
+	hex.Encode(buf[19:23], u[8:10])
+	buf[23] = '-'
+	hex.Encode(buf[24:], u[10:])
+	return string(buf[:])
+}
+
+// ParseUUID parses the canonical text form of a UUID, such as
+// "123e4567-e89b-12d3-a456-426614174000", in any letter case.
+func ParseUUID(s string) (UUID, error) {
+	var u UUID
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return u, fmt.Errorf("%w: UUID %q", ErrInvalidID, s)
+	}
+	digits := s[0:8] + s[9:13] + s[14:18] + s[19:23] + s[24:]
+	if _, err := hex.Decode(u[:], []byte(digits)); err != nil {
+		return u, fmt.Errorf("%w: UUID %q", ErrInvalidID, s)
+	}
+	return u, nil
+}
+
+// ULID is a universally unique lexicographically sortable identifier, in upper case.
+type ULID string
+
+const crockford = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// ParseULID checks the lexical form of a ULID, 26 Crockford base32 characters
+// not exceeding "7ZZZZZZZZZZZZZZZZZZZZZZZZZ", and normalizes it to upper case.
+func ParseULID(s string) (ULID, error) {
+	u := strings.ToUpper(s)
+	if len(u) != 26 || u[0] > '7' {
+		return "", fmt.Errorf("%w: ULID %q", ErrInvalidID, s)
+	}
+	for i := 0; i < len(u); i++ {
+		if strings.IndexByte(crockford, u[i]) < 0 {
+			return "", fmt.Errorf("%w: ULID %q", ErrInvalidID, s)
+		}
+	}
+	return ULID(u), nil
+}
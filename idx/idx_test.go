@@ -0,0 +1,34 @@
+package idx
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+)
+
+func TestParse(t *testing.T) {
+	const s = "123e4567-e89b-12d3-a456-426614174000"
+	if Parse(s, ParseUUID).Unwrap().String() != s {
+		t.Error("ParseUUID failed")
+	}
+	err := Parse("123e4567e89b12d3a456426614174000", ParseUUID).UnwrapError()
+	if !errors.Is(err, ErrInvalidID) || !errors.Is(err, errkind.Invalid) {
+		t.Error("ParseUUID failed")
+	}
+	if Parse("123e4567-e89b-12d3-a456-42661417400g", ParseUUID).IsOk() {
+		t.Error("ParseUUID failed")
+	}
+
+	if *Parse("01arz3ndektsv4rrffq69g5fav", ParseULID).Unwrap() != "01ARZ3NDEKTSV4RRFFQ69G5FAV" {
+		t.Error("ParseULID failed")
+	}
+	if Parse("81ARZ3NDEKTSV4RRFFQ69G5FAV", ParseULID).IsOk() || Parse("01ARZ3NDEKTSV4RRFFQ69G5FAU", ParseULID).IsOk() {
+		t.Error("ParseULID failed")
+	}
+
+	if *Parse("42", strconv.Atoi).Unwrap() != 42 {
+		t.Error("Parse failed")
+	}
+}
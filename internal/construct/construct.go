@@ -0,0 +1,8 @@
+// Package construct lets the other packages of this module build options they allocate
+// themselves, e.g. from an arena, reporting them like the constructors of package option.
+package construct
+
+// Option sets the `*option.Option[T]` `o` to hold the `*T` `v`, nil for a [`None`], and reports
+// it as created: the None hook is called for a [`None`] and the tracker and counters are notified.
+// It is set by package option.
+var Option func(o, v any)
@@ -0,0 +1,146 @@
+package fields
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yuanzicheng/go-result-and-option/internal/peek"
+	"github.com/yuanzicheng/go-result-and-option/option"
+)
+
+var (
+	optionPkgPath       = reflect.TypeFor[option.Option[int]]().PkgPath()
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+	durationType        = reflect.TypeFor[time.Duration]()
+)
+
+// IsOption reports whether `t` is an `option.Option` type.
+func IsOption(t reflect.Type) bool {
+	return t.PkgPath() == optionPkgPath && strings.HasPrefix(t.Name(), "Option[")
+}
+
+// OptionElem returns the type of the value contained in the `option.Option` type `t`.
+func OptionElem(t reflect.Type) reflect.Type {
+	m, _ := reflect.PointerTo(t).MethodByName("UnwrapOrDefault")
+	return m.Type.Out(0).Elem()
+}
+
+// OptionValue returns the value of the `option.Option` `f` as a `*T`, nil for a [`None`],
+// without calling the None hook.
+func OptionValue(f reflect.Value) reflect.Value {
+	p := reflect.New(f.Type())
+	p.Elem().Set(f)
+	return reflect.ValueOf(peek.Option(p.Interface()))
+}
+
+// Name returns the name of a struct field: its `tag` tag if any, otherwise its name.
+// Returns the empty string for unexported fields and fields tagged "-".
+// Tag options after a comma are ignored.
+func Name(f reflect.StructField, tag string) string {
+	if !f.IsExported() {
+		return ""
+	}
+	name, _, _ := strings.Cut(f.Tag.Get(tag), ",")
+	if name == "-" {
+		return ""
+	}
+	if name != "" {
+		return name
+	}
+	return f.Name
+}
+
+// ByName returns the field of the struct `v` with the name, matching names case-insensitively.
+func ByName(v reflect.Value, tag, name string) (reflect.Value, bool) {
+	t := v.Type()
+	for i := range t.NumField() {
+		if n := Name(t.Field(i), tag); n != "" && strings.EqualFold(n, name) {
+			return v.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// SetSome sets the addressable field `f` to the value parsed from `s`, wrapped
+// in a [`Some`] for `Option` fields. Values are parsed by their
+// `encoding.TextUnmarshaler` implementation, `time.ParseDuration` for durations
+// or `strconv` for strings, booleans and numbers.
+func SetSome(f reflect.Value, s string) error {
+	if IsOption(f.Type()) {
+		v := reflect.New(OptionElem(f.Type()))
+		if err := Set(v.Elem(), s); err != nil {
+			return err
+		}
+		f.Addr().MethodByName("Replace").Call([]reflect.Value{v})
+		return nil
+	}
+	return Set(f, s)
+}
+
+// SetAll sets the addressable field `f` to the values parsed from `vs`, see SetSome:
+// slice fields get all the values, other fields the first one.
+func SetAll(f reflect.Value, vs []string) error {
+	if f.Kind() != reflect.Slice {
+		return SetSome(f, vs[0])
+	}
+	s := reflect.MakeSlice(f.Type(), len(vs), len(vs))
+	for i, v := range vs {
+		if err := Set(s.Index(i), v); err != nil {
+			return err
+		}
+	}
+	f.Set(s)
+	return nil
+}
+
+// Set sets the addressable field `f` to the value parsed from `s`, like SetSome
+// but without special handling of options.
+func Set(f reflect.Value, s string) error {
+	if f.Addr().Type().Implements(textUnmarshalerType) {
+		return f.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(s))
+	}
+	if f.Type() == durationType {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		f.SetInt(int64(d))
+		return nil
+	}
+
+	switch f.Kind() {
+	case reflect.String:
+		f.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		f.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(s, 10, f.Type().Bits())
+		if err != nil {
+			return err
+		}
+		f.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, err := strconv.ParseUint(s, 10, f.Type().Bits())
+		if err != nil {
+			return err
+		}
+		f.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(s, f.Type().Bits())
+		if err != nil {
+			return err
+		}
+		f.SetFloat(n)
+	default:
+		return fmt.Errorf("unsupported field type %v", f.Type())
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+package fields
+
+import (
+	"net/netip"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+)
+
+type record struct {
+	Name    string `csv:"name"`
+	Age     option.Option[int]
+	Timeout time.Duration `csv:"timeout,omitempty"`
+	Addr    netip.Addr
+	Skipped string `csv:"-"`
+	secret  string
+}
+
+func TestName(t *testing.T) {
+	rt := reflect.TypeFor[record]()
+	var names []string
+	for i := range rt.NumField() {
+		names = append(names, Name(rt.Field(i), "csv"))
+	}
+	if !reflect.DeepEqual(names, []string{"name", "Age", "timeout", "Addr", "", ""}) {
+		t.Errorf("Name failed: %v", names)
+	}
+	if _, ok := ByName(reflect.ValueOf(record{}), "csv", "AGE"); !ok {
+		t.Error("ByName failed")
+	}
+}
+
+func TestSetSome(t *testing.T) {
+	var r record
+	v := reflect.ValueOf(&r).Elem()
+	for name, s := range map[string]string{"Name": "ann", "Age": "42", "Timeout": "2s", "Addr": "10.0.0.1"} {
+		if err := SetSome(v.FieldByName(name), s); err != nil {
+			t.Error(err)
+		}
+	}
+	if r.Name != "ann" || *r.Age.Unwrap("") != 42 || r.Timeout != 2*time.Second || r.Addr.String() != "10.0.0.1" {
+		t.Errorf("SetSome failed: %+v", r)
+	}
+	if SetSome(v.FieldByName("Age"), "x") == nil || r.Age.IsNone() {
+		t.Error("SetSome failed on invalid value")
+	}
+	var c chan int
+	if Set(reflect.ValueOf(&c).Elem(), "") == nil {
+		t.Error("Set accepted unsupported type")
+	}
+}
+
+func TestOptionElem(t *testing.T) {
+	if OptionElem(reflect.TypeFor[option.Option[[]int]]()) != reflect.TypeFor[[]int]() {
+		t.Error("OptionElem failed")
+	}
+}
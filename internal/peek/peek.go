@@ -0,0 +1,12 @@
+// Package peek reads options and results for the other packages of this module without
+// calling the error and None hooks nor marking them consumed for resultdebug, since
+// sorting, hashing or printing a value only observes it.
+package peek
+
+// Option returns the value of a `*option.Option[T]` as a `*T`, nil for a [`None`] or a nil option.
+// It is set by package option.
+var Option func(o any) any
+
+// Result returns the value of a `*result.Result[T]` as a `*T`, and its error.
+// It is set by package result.
+var Result func(r any) (value any, err error)
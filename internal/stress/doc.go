@@ -0,0 +1,8 @@
+// Package stress holds the stress tests of the concurrent types, meant to be run with
+// the race detector:
+//
+//	go test -race ./internal/stress
+//
+// Every goroutine draws its operations from a seed derived from its index, so a failure
+// reproduces with the same interleaving of operations per goroutine.
+package stress
@@ -0,0 +1,172 @@
+package stress
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/future"
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+	"github.com/yuanzicheng/go-result-and-option/singleflightx"
+	"github.com/yuanzicheng/go-result-and-option/syncx"
+)
+
+// hammer runs `f` in many goroutines, each with its own deterministic source of randomness.
+func hammer(t *testing.T, f func(r *rand.Rand)) {
+	t.Helper()
+	goroutines, ops := 1000, 100
+	if testing.Short() {
+		goroutines, ops = 100, 10
+	}
+	var wg sync.WaitGroup
+	for i := range goroutines {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r := rand.New(rand.NewPCG(uint64(i), 0x5eed))
+			for range ops {
+				f(r)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestAtomicOption(t *testing.T) {
+	var a syncx.AtomicOption[int]
+	var stored, taken atomic.Int64
+	hammer(t, func(r *rand.Rand) {
+		v := r.IntN(100)
+		switch r.IntN(4) {
+		case 0:
+			a.Store(&v)
+		case 1:
+			a.Swap(&v)
+		case 2:
+			if a.CompareAndSwap(nil, &v) {
+				stored.Add(1)
+			}
+		case 3:
+			if a.Take().IsSome() {
+				taken.Add(1)
+			}
+		}
+		if o := a.Load(); o.IsSome() && *o.Unwrap("") >= 100 {
+			t.Errorf("AtomicOption loaded %d", *o.Unwrap(""))
+		}
+	})
+	if stored.Load() == 0 || taken.Load() == 0 {
+		t.Errorf("AtomicOption stressed poorly: %d stored, %d taken", stored.Load(), taken.Load())
+	}
+}
+
+func TestAtomicResult(t *testing.T) {
+	var a syncx.AtomicResult[int]
+	boom := errors.New("boom")
+	hammer(t, func(r *rand.Rand) {
+		v := r.IntN(100)
+		if r.IntN(2) == 0 {
+			a.StoreIfOk(result.Err[int](boom))
+		} else {
+			a.StoreIfOk(result.Ok(&v))
+		}
+		if o := a.Load(); o.IsSome() && o.Unwrap("").IsErr() {
+			t.Error("AtomicResult stored an Err")
+		}
+	})
+}
+
+func TestLockedOption(t *testing.T) {
+	var l syncx.LockedOption[int]
+	var total atomic.Int64
+	hammer(t, func(r *rand.Rand) {
+		switch r.IntN(3) {
+		case 0:
+			l.With(func(o *option.Option[int]) {
+				v := o.UnwrapOrDefault()
+				n := 1
+				if v != nil {
+					n += *v
+				}
+				o.Replace(&n)
+			})
+			total.Add(1)
+		case 1:
+			l.Get()
+		case 2:
+			l.TakeIf(func(*int) bool { return false })
+		}
+	})
+	if got := l.Get().UnwrapOrDefault(); got == nil || int64(*got) != total.Load() {
+		t.Errorf("LockedOption lost updates: %v, want %d", got, total.Load())
+	}
+}
+
+func TestMap(t *testing.T) {
+	var m syncx.Map[int, int]
+	hammer(t, func(r *rand.Rand) {
+		k := r.IntN(10)
+		switch r.IntN(4) {
+		case 0:
+			m.Store(k, k)
+		case 1:
+			m.LoadOrStore(k, k)
+		case 2:
+			m.Pop(k)
+		case 3:
+			if o := m.Load(k); o.IsSome() && *o.Unwrap("") != k {
+				t.Errorf("Map loaded %d for key %d", *o.Unwrap(""), k)
+			}
+		}
+	})
+}
+
+func TestFuture(t *testing.T) {
+	fut, complete := future.New[int]()
+	var completions atomic.Int64
+	hammer(t, func(r *rand.Rand) {
+		if r.IntN(10) == 0 {
+			v := r.IntN(100)
+			completions.Add(1)
+			complete(result.Ok(&v))
+		}
+		if o := fut.Poll(); o.IsSome() && o.Unwrap("").IsErr() {
+			t.Error("Future completed with an Err")
+		}
+	})
+	if completions.Load() == 0 {
+		t.Skip("future never completed")
+	}
+	first := fut.Await(context.Background()).Unwrap()
+	hammer(t, func(*rand.Rand) {
+		if got := fut.Await(context.Background()).Unwrap(); got != first {
+			t.Error("Future changed after completion")
+		}
+	})
+}
+
+func TestGroup(t *testing.T) {
+	var g singleflightx.Group[int]
+	g.Clone = func(v *int) *int {
+		c := *v
+		return &c
+	}
+	hammer(t, func(r *rand.Rand) {
+		k := r.IntN(10)
+		res := g.Do(strconv.Itoa(k), func() *result.Result[int] {
+			if r.IntN(5) == 0 {
+				return result.Err[int](errors.New("boom"))
+			}
+			v := k
+			return result.Ok(&v)
+		})
+		if res.IsOk() && *res.Unwrap() != k {
+			t.Errorf("Group returned %d for key %d", *res.Unwrap(), k)
+		}
+	})
+}
@@ -0,0 +1,7 @@
+module github.com/yuanzicheng/go-result-and-option/interop/mo
+
+go 1.25.0
+
+require github.com/yuanzicheng/go-result-and-option v0.0.0
+
+replace github.com/yuanzicheng/go-result-and-option => ../../
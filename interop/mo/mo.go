@@ -0,0 +1,104 @@
+// Package mo converts between the options and results of this library and those of
+// github.com/samber/mo, and adapts the lo-style `(value, ok)` tuples of github.com/samber/lo.
+//
+// The package relies on the method sets of `mo.Option`, `mo.Result` and `lo.Tuple2` rather than
+// importing them, so it works with any of their versions:
+//
+//	o := mo.FromOption(moOption)
+//	m := mo.ToOption(o, samber.Some[int], samber.None[int])
+//	f := mo.FromTuple(lo.Find(users, isAdmin))
+package mo
+
+import (
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// OptionGetter is implemented by `mo.Option[T]`.
+type OptionGetter[T any] interface {
+	Get() (T, bool)
+}
+
+// ResultGetter is implemented by `mo.Result[T]`.
+type ResultGetter[T any] interface {
+	Get() (T, error)
+}
+
+// Unpacker is implemented by `lo.Tuple2[T, bool]`.
+type Unpacker[T any] interface {
+	Unpack() (T, bool)
+}
+
+// FromOption converts a `mo.Option[T]` to an option.
+func FromOption[T any](o OptionGetter[T]) *option.Option[T] {
+	return FromTuple(o.Get())
+}
+
+// ToOption converts an option to a `mo.Option[T]` built by `some` or `none`,
+// typically `mo.Some[T]` and `mo.None[T]`.
+func ToOption[T, M any](o *option.Option[T], some func(T) M, none func() M) M {
+	if o.IsNone() {
+		return none()
+	}
+	return some(*o.Unwrap(""))
+}
+
+// FromResult converts a `mo.Result[T]` to a result.
+func FromResult[T any](r ResultGetter[T]) *result.Result[T] {
+	return FromErrTuple(r.Get())
+}
+
+// ToResult converts a result to a `mo.Result[T]` built by `ok` or `err`,
+// typically `mo.Ok[T]` and `mo.Err[T]`. A nil [`Ok`] value converts to the zero value.
+func ToResult[T, M any](r *result.Result[T], ok func(T) M, err func(error) M) M {
+	if r.IsErr() {
+		return err(r.UnwrapError())
+	}
+	var v T
+	if p := r.Unwrap(); p != nil {
+		v = *p
+	}
+	return ok(v)
+}
+
+// FromTuple converts a `(value, ok)` tuple, e.g. returned by `lo.Find`, to an option.
+func FromTuple[T any](v T, ok bool) *option.Option[T] {
+	if !ok {
+		return option.None[T]()
+	}
+	return option.Some(&v)
+}
+
+// FromPair converts a `lo.Tuple2[T, bool]` to an option.
+func FromPair[T any](t Unpacker[T]) *option.Option[T] {
+	return FromTuple(t.Unpack())
+}
+
+// ToTuple converts an option to a `(value, ok)` tuple, the zero value standing for [`None`].
+func ToTuple[T any](o *option.Option[T]) (T, bool) {
+	if o.IsNone() {
+		var zero T
+		return zero, false
+	}
+	return *o.Unwrap(""), true
+}
+
+// FromErrTuple converts a `(value, error)` tuple, e.g. returned by `lo.Attempt` or any `(T, error)` API, to a result.
+func FromErrTuple[T any](v T, err error) *result.Result[T] {
+	if err != nil {
+		return result.Err[T](err)
+	}
+	return result.Ok(&v)
+}
+
+// ToErrTuple converts a result to a `(value, error)` tuple. A nil [`Ok`] value converts to the zero value.
+func ToErrTuple[T any](r *result.Result[T]) (T, error) {
+	var v T
+	if r.IsErr() {
+		return v, r.UnwrapError()
+	}
+	if p := r.Unwrap(); p != nil {
+		v = *p
+	}
+	return v, nil
+}
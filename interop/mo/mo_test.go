@@ -0,0 +1,90 @@
+package mo
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// moOption and moResult mimic the method sets of `mo.Option` and `mo.Result`.
+type moOption[T any] struct {
+	value   T
+	present bool
+}
+
+func (o moOption[T]) Get() (T, bool) { return o.value, o.present }
+
+func some[T any](v T) moOption[T] { return moOption[T]{value: v, present: true} }
+func none[T any]() moOption[T]    { return moOption[T]{} }
+
+type moResult[T any] struct {
+	value T
+	err   error
+}
+
+func (r moResult[T]) Get() (T, error) { return r.value, r.err }
+
+func ok[T any](v T) moResult[T]         { return moResult[T]{value: v} }
+func fail[T any](err error) moResult[T] { return moResult[T]{err: err} }
+
+// tuple2 mimics `lo.Tuple2`.
+type tuple2[A, B any] struct {
+	A A
+	B B
+}
+
+func (t tuple2[A, B]) Unpack() (A, B) { return t.A, t.B }
+
+func TestOption(t *testing.T) {
+	if o := FromOption(some(1)); *o.Unwrap("") != 1 {
+		t.Error("FromOption failed on Some")
+	}
+	if FromOption(none[int]()).IsSome() {
+		t.Error("FromOption failed on None")
+	}
+	x := 1
+	if m := ToOption(option.Some(&x), some[int], none[int]); !m.present || m.value != 1 {
+		t.Error("ToOption failed on Some")
+	}
+	if ToOption(option.None[int](), some[int], none[int]).present {
+		t.Error("ToOption failed on None")
+	}
+}
+
+func TestResult(t *testing.T) {
+	boom := errors.New("boom")
+	if r := FromResult(ok(1)); *r.Unwrap() != 1 {
+		t.Error("FromResult failed on Ok")
+	}
+	if FromResult(fail[int](boom)).UnwrapError() != boom {
+		t.Error("FromResult failed on Err")
+	}
+	if m := ToResult(result.Ok[int](nil), ok[int], fail[int]); m.err != nil || m.value != 0 {
+		t.Error("ToResult failed on a nil Ok")
+	}
+	if ToResult(result.Err[int](boom), ok[int], fail[int]).err != boom {
+		t.Error("ToResult failed on Err")
+	}
+}
+
+func TestTuple(t *testing.T) {
+	if FromPair(tuple2[string, bool]{"a", true}).UnwrapOrDefault() == nil || FromTuple("", false).IsSome() {
+		t.Error("FromTuple failed")
+	}
+	x := 1
+	if v, ok := ToTuple(option.Some(&x)); !ok || v != 1 {
+		t.Error("ToTuple failed")
+	}
+	if _, ok := ToTuple(option.None[int]()); ok {
+		t.Error("ToTuple failed on None")
+	}
+	boom := errors.New("boom")
+	if _, err := ToErrTuple(FromErrTuple(0, boom)); err != boom {
+		t.Error("ErrTuple failed")
+	}
+	if v, err := ToErrTuple(FromErrTuple(2, nil)); err != nil || v != 2 {
+		t.Error("ErrTuple failed on Ok")
+	}
+}
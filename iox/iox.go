@@ -0,0 +1,48 @@
+package iox
+
+import (
+	"errors"
+	"io"
+	"math"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// ErrTooLarge is returned by LimitedReadAll when the reader holds more than the limit.
+var ErrTooLarge = errors.New("iox: data exceeds the size limit")
+
+// ReadAll reads from `r` until EOF, as `io.ReadAll` does.
+func ReadAll(r io.Reader) *result.Result[[]byte] {
+	data, err := io.ReadAll(r)
+	return result.New(&data, err)
+}
+
+// LimitedReadAll reads from `r` until EOF, returning an [`Err`] of ErrTooLarge
+// as soon as more than `limit` bytes have been read. A limit of `math.MaxInt64` reads everything.
+func LimitedReadAll(r io.Reader, limit int64) *result.Result[[]byte] {
+	if limit < math.MaxInt64 {
+		// Read one more byte than the limit to tell a reader holding exactly `limit` bytes from a larger one.
+		r = io.LimitReader(r, limit+1)
+	}
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return result.Err[[]byte](err)
+	}
+	if int64(len(data)) > limit {
+		return result.Err[[]byte](ErrTooLarge)
+	}
+	return result.Ok(&data)
+}
+
+// Copy copies from `src` to `dst` until EOF, returning the number of bytes copied,
+// as `io.Copy` does.
+func Copy(dst io.Writer, src io.Reader) *result.Result[int64] {
+	n, err := io.Copy(dst, src)
+	return result.New(&n, err)
+}
+
+// ReadFull reads exactly `len(buf)` bytes from `r` into `buf`, as `io.ReadFull` does.
+func ReadFull(r io.Reader, buf []byte) *result.Result[int] {
+	n, err := io.ReadFull(r, buf)
+	return result.New(&n, err)
+}
@@ -0,0 +1,37 @@
+package iox
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestRead(t *testing.T) {
+	if string(*ReadAll(strings.NewReader("abc")).Unwrap()) != "abc" {
+		t.Error("ReadAll failed")
+	}
+	if string(*LimitedReadAll(strings.NewReader("abc"), 3).Unwrap()) != "abc" {
+		t.Error("LimitedReadAll failed")
+	}
+	if !errors.Is(LimitedReadAll(strings.NewReader("abcd"), 3).UnwrapError(), ErrTooLarge) {
+		t.Error("LimitedReadAll failed to guard the size")
+	}
+	if string(*LimitedReadAll(strings.NewReader("abc"), math.MaxInt64).Unwrap()) != "abc" {
+		t.Error("LimitedReadAll failed with the largest limit")
+	}
+
+	buf := make([]byte, 4)
+	if !errors.Is(ReadFull(strings.NewReader("abc"), buf).UnwrapError(), io.ErrUnexpectedEOF) {
+		t.Error("ReadFull failed")
+	}
+}
+
+func TestCopy(t *testing.T) {
+	var dst bytes.Buffer
+	if *Copy(&dst, strings.NewReader("abc")).Unwrap() != 3 || dst.String() != "abc" {
+		t.Error("Copy failed")
+	}
+}
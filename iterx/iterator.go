@@ -0,0 +1,99 @@
+package iterx
+
+import (
+	"iter"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+)
+
+// Iterator is a pull-style iterator, Next returns [`None`] once it is exhausted.
+type Iterator[T any] interface {
+	Next() *option.Option[T]
+}
+
+// IteratorFunc adapts a function to an Iterator.
+type IteratorFunc[T any] func() *option.Option[T]
+
+// Next calls `f`.
+func (f IteratorFunc[T]) Next() *option.Option[T] {
+	return f()
+}
+
+// FromSlice returns an iterator over the elements of the slice.
+func FromSlice[T any](s []T) Iterator[T] {
+	i := 0
+	return IteratorFunc[T](func() *option.Option[T] {
+		if i >= len(s) {
+			return option.None[T]()
+		}
+		v := s[i]
+		i++
+		return option.Some(&v)
+	})
+}
+
+// FromSeq returns an iterator over the sequence, along with a function
+// that must be called to release it if it isn't consumed to the end.
+func FromSeq[T any](seq iter.Seq[T]) (Iterator[T], func()) {
+	next, stop := iter.Pull(seq)
+	return IteratorFunc[T](func() *option.Option[T] {
+		v, ok := next()
+		if !ok {
+			return option.None[T]()
+		}
+		return option.Some(&v)
+	}), stop
+}
+
+// Seq returns a sequence of the values of the iterator.
+func Seq[T any](it Iterator[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for o := it.Next(); o.IsSome(); o = it.Next() {
+			if !yield(*o.UnwrapOrDefault()) {
+				return
+			}
+		}
+	}
+}
+
+// ToSlice consumes the iterator and returns its values.
+func ToSlice[T any](it Iterator[T]) []T {
+	var out []T
+	for v := range Seq(it) {
+		out = append(out, v)
+	}
+	return out
+}
+
+// Map returns an iterator applying `f` to the values of `it`.
+func Map[T any, U any](it Iterator[T], f func(T) U) Iterator[U] {
+	return IteratorFunc[U](func() *option.Option[U] {
+		return option.Map(it.Next(), func(v *T) *U {
+			u := f(*v)
+			return &u
+		})
+	})
+}
+
+// Filter returns an iterator over the values of `it` satisfying the predicate.
+func Filter[T any](it Iterator[T], pred func(T) bool) Iterator[T] {
+	return IteratorFunc[T](func() *option.Option[T] {
+		for {
+			o := it.Next()
+			if o.IsNone() || pred(*o.UnwrapOrDefault()) {
+				return o
+			}
+		}
+	})
+}
+
+// Take returns an iterator over the first `n` values of `it`.
+func Take[T any](it Iterator[T], n int) Iterator[T] {
+	return IteratorFunc[T](func() *option.Option[T] {
+		if n <= 0 {
+			return option.None[T]()
+		}
+		n--
+		return it.Next()
+	})
+}
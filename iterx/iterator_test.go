@@ -0,0 +1,29 @@
+package iterx
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestIterator(t *testing.T) {
+	it := FromSlice([]int{1, 2, 3, 4, 5})
+	even := Filter(it, func(v int) bool { return v%2 == 0 })
+	doubled := Map(even, func(v int) int { return v * 2 })
+	if got := ToSlice(Take(doubled, 1)); !slices.Equal(got, []int{4}) {
+		t.Errorf("Iterator failed: %v", got)
+	}
+	if got := ToSlice(doubled); !slices.Equal(got, []int{8}) {
+		t.Errorf("Iterator failed: %v", got)
+	}
+	if it.Next().IsSome() {
+		t.Error("Iterator failed to stop")
+	}
+}
+
+func TestFromSeq(t *testing.T) {
+	it, stop := FromSeq(slices.Values([]string{"a", "b"}))
+	defer stop()
+	if got := slices.Collect(Seq(it)); !slices.Equal(got, []string{"a", "b"}) {
+		t.Error("FromSeq failed")
+	}
+}
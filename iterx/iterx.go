@@ -0,0 +1,102 @@
+package iterx
+
+import (
+	"iter"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// FromSeq2 converts a sequence of `(value, error)` pairs into a sequence of results.
+func FromSeq2[T any](seq iter.Seq2[T, error]) iter.Seq[*result.Result[T]] {
+	return func(yield func(*result.Result[T]) bool) {
+		for v, err := range seq {
+			if !yield(result.New(&v, err)) {
+				return
+			}
+		}
+	}
+}
+
+// ToSeq2 converts a sequence of results into a sequence of `(value, error)` pairs.
+// An [`Err`] or a nil [`Ok`] value yields the zero value of `T`.
+func ToSeq2[T any](seq iter.Seq[*result.Result[T]]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for r := range seq {
+			var err error
+			if r.IsErr() {
+				err = r.UnwrapError()
+			}
+			if !yield(valueOf(r), err) {
+				return
+			}
+		}
+	}
+}
+
+// Try calls `f` with the value of every result, stopping at the first [`Err`]
+// or the first error returned by `f` and returning it.
+func Try[T any](seq iter.Seq[*result.Result[T]], f func(T) error) error {
+	for r := range seq {
+		if r.IsErr() {
+			return r.UnwrapError()
+		}
+		if err := f(valueOf(r)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CollectOrErr collects the values of the results into an [`Ok`] slice,
+// stopping at the first [`Err`] and returning it.
+func CollectOrErr[T any](seq iter.Seq[*result.Result[T]]) *result.Result[[]T] {
+	var out []T
+	for r := range seq {
+		if r.IsErr() {
+			return result.Err[[]T](r.UnwrapError())
+		}
+		out = append(out, valueOf(r))
+	}
+	return result.Ok(&out)
+}
+
+// valueOf returns the [`Ok`] value of the result, or the zero value of `T`.
+func valueOf[T any](r *result.Result[T]) T {
+	var v T
+	if p := r.UnwrapOrDefault(); p != nil {
+		v = *p
+	}
+	return v
+}
+
+// Chunk groups the [`Ok`] values into slices of up to `n` values.
+// An [`Err`] ends the current chunk early and is yielded as a failed chunk of its own.
+func Chunk[T any](seq iter.Seq[*result.Result[T]], n int) iter.Seq[*result.Result[[]T]] {
+	if n < 1 {
+		panic("iterx: chunk size must be positive")
+	}
+	return func(yield func(*result.Result[[]T]) bool) {
+		var chunk []T
+		flush := func() bool {
+			if len(chunk) == 0 {
+				return true
+			}
+			out := chunk
+			chunk = nil
+			return yield(result.Ok(&out))
+		}
+		for r := range seq {
+			if r.IsErr() {
+				if !flush() || !yield(result.Err[[]T](r.UnwrapError())) {
+					return
+				}
+				continue
+			}
+			chunk = append(chunk, valueOf(r))
+			if len(chunk) == n && !flush() {
+				return
+			}
+		}
+		flush()
+	}
+}
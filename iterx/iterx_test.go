@@ -0,0 +1,81 @@
+package iterx
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestSeq2(t *testing.T) {
+	boom := errors.New("boom")
+	seq := func(yield func(int, error) bool) {
+		_ = yield(1, nil) && yield(0, boom) && yield(3, nil)
+	}
+
+	n := 0
+	for r := range FromSeq2(seq) {
+		if n == 1 && !r.IsErr() || n != 1 && !r.IsOk() {
+			t.Error("FromSeq2 failed")
+		}
+		n++
+	}
+	if n != 3 {
+		t.Error("FromSeq2 failed")
+	}
+
+	sum := 0
+	for v, err := range ToSeq2(FromSeq2(seq)) {
+		if err != nil {
+			break
+		}
+		sum += v
+	}
+	if sum != 1 {
+		t.Error("ToSeq2 failed")
+	}
+}
+
+func TestTry(t *testing.T) {
+	boom := errors.New("boom")
+	seq := FromSeq2(func(yield func(int, error) bool) {
+		_ = yield(1, nil) && yield(0, boom) && yield(3, nil)
+	})
+
+	n := 0
+	err := Try(seq, func(v int) error {
+		n += v
+		return nil
+	})
+	if !errors.Is(err, boom) || n != 1 {
+		t.Error("Try failed")
+	}
+	if !errors.Is(CollectOrErr(seq).UnwrapError(), boom) {
+		t.Error("CollectOrErr failed")
+	}
+
+	ok := FromSeq2(func(yield func(int, error) bool) {
+		_ = yield(1, nil) && yield(2, nil)
+	})
+	if vs := CollectOrErr(ok).Unwrap(); len(*vs) != 2 {
+		t.Error("CollectOrErr failed")
+	}
+}
+
+func TestChunk(t *testing.T) {
+	boom := errors.New("boom")
+	seq := FromSeq2(func(yield func(int, error) bool) {
+		_ = yield(1, nil) && yield(2, nil) && yield(3, nil) && yield(0, boom) && yield(5, nil)
+	})
+
+	var sizes []int
+	for r := range Chunk(seq, 2) {
+		if r.IsErr() {
+			sizes = append(sizes, -1)
+			continue
+		}
+		sizes = append(sizes, len(*r.Unwrap()))
+	}
+	if !slices.Equal(sizes, []int{2, 1, -1, 1}) {
+		t.Errorf("Chunk failed: %v", sizes)
+	}
+}
@@ -0,0 +1,29 @@
+package iterx
+
+import (
+	"iter"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+)
+
+// SomeOnly yields the values of the [`Some`] options, skipping the [`None`] ones.
+func SomeOnly[T any](seq iter.Seq[*option.Option[T]]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for o := range seq {
+			if o.IsSome() && !yield(*o.UnwrapOrDefault()) {
+				return
+			}
+		}
+	}
+}
+
+// WhileSome yields the values of the options up to the first [`None`].
+func WhileSome[T any](seq iter.Seq[*option.Option[T]]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for o := range seq {
+			if o.IsNone() || !yield(*o.UnwrapOrDefault()) {
+				return
+			}
+		}
+	}
+}
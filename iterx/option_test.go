@@ -0,0 +1,19 @@
+package iterx
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+)
+
+func TestSomeOnly(t *testing.T) {
+	x, y := 1, 2
+	seq := slices.Values([]*option.Option[int]{option.Some(&x), option.None[int](), option.Some(&y)})
+	if got := slices.Collect(SomeOnly(seq)); len(got) != 2 {
+		t.Error("SomeOnly failed")
+	}
+	if got := slices.Collect(WhileSome(seq)); len(got) != 1 {
+		t.Error("WhileSome failed")
+	}
+}
@@ -0,0 +1,55 @@
+package iterx
+
+import (
+	"iter"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+)
+
+// Pair holds two values yielded together by Zip and ZipLongest.
+type Pair[A any, B any] struct {
+	First  A
+	Second B
+}
+
+// Zip pairs up the values of two sequences, stopping when the shorter one ends.
+func Zip[A any, B any](a iter.Seq[A], b iter.Seq[B]) iter.Seq[Pair[A, B]] {
+	return func(yield func(Pair[A, B]) bool) {
+		nextB, stop := iter.Pull(b)
+		defer stop()
+		for va := range a {
+			vb, ok := nextB()
+			if !ok || !yield(Pair[A, B]{va, vb}) {
+				return
+			}
+		}
+	}
+}
+
+// ZipLongest pairs up the values of two sequences until both end,
+// the shorter one being padded with [`None`].
+func ZipLongest[A any, B any](a iter.Seq[A], b iter.Seq[B]) iter.Seq[Pair[*option.Option[A], *option.Option[B]]] {
+	return func(yield func(Pair[*option.Option[A], *option.Option[B]]) bool) {
+		nextA, stopA := iter.Pull(a)
+		defer stopA()
+		nextB, stopB := iter.Pull(b)
+		defer stopB()
+		for {
+			va, okA := nextA()
+			vb, okB := nextB()
+			if !okA && !okB {
+				return
+			}
+			p := Pair[*option.Option[A], *option.Option[B]]{option.None[A](), option.None[B]()}
+			if okA {
+				p.First = option.Some(&va)
+			}
+			if okB {
+				p.Second = option.Some(&vb)
+			}
+			if !yield(p) {
+				return
+			}
+		}
+	}
+}
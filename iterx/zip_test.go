@@ -0,0 +1,21 @@
+package iterx
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestZip(t *testing.T) {
+	a := slices.Values([]int{1, 2, 3})
+	b := slices.Values([]string{"a", "b"})
+
+	pairs := slices.Collect(Zip(a, b))
+	if len(pairs) != 2 || pairs[1].First != 2 || pairs[1].Second != "b" {
+		t.Error("Zip failed")
+	}
+
+	longest := slices.Collect(ZipLongest(a, b))
+	if len(longest) != 3 || *longest[2].First.Unwrap("") != 3 || longest[2].Second.IsSome() {
+		t.Error("ZipLongest failed")
+	}
+}
@@ -0,0 +1,65 @@
+package jsonx
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// ErrTrailingData is returned by Unmarshal when data follows the top-level value.
+var ErrTrailingData = errors.New("jsonx: unexpected data after top-level value")
+
+// Opt configures the decoding.
+type Opt func(*json.Decoder)
+
+// DisallowUnknownFields makes decoding fail on object keys that don't match any struct field.
+func DisallowUnknownFields() Opt {
+	return func(d *json.Decoder) {
+		d.DisallowUnknownFields()
+	}
+}
+
+// UseNumber decodes numbers into an `interface{}` as `json.Number` instead of float64.
+func UseNumber() Opt {
+	return func(d *json.Decoder) {
+		d.UseNumber()
+	}
+}
+
+// Marshal returns the JSON encoding of `v`, as `json.Marshal` does.
+func Marshal(v any) *result.Result[[]byte] {
+	data, err := json.Marshal(v)
+	return result.New(&data, err)
+}
+
+// Unmarshal decodes the JSON document into a `T`.
+func Unmarshal[T any](data []byte, opts ...Opt) *result.Result[T] {
+	dec := newDecoder(bytes.NewReader(data), opts)
+	var v T
+	if err := dec.Decode(&v); err != nil {
+		return result.Err[T](err)
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return result.Err[T](ErrTrailingData)
+	}
+	return result.Ok(&v)
+}
+
+// DecodeReader decodes the first JSON value read from `r` into a `T`.
+// Data following the value may be consumed from `r` as well.
+func DecodeReader[T any](r io.Reader, opts ...Opt) *result.Result[T] {
+	var v T
+	err := newDecoder(r, opts).Decode(&v)
+	return result.New(&v, err)
+}
+
+func newDecoder(r io.Reader, opts []Opt) *json.Decoder {
+	dec := json.NewDecoder(r)
+	for _, opt := range opts {
+		opt(dec)
+	}
+	return dec
+}
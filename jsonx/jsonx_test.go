@@ -0,0 +1,36 @@
+package jsonx
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+type user struct {
+	Name string `json:"name"`
+}
+
+func TestUnmarshal(t *testing.T) {
+	if Unmarshal[user]([]byte(`{"name":"alice"}`)).Unwrap().Name != "alice" {
+		t.Error("Unmarshal failed")
+	}
+	if Unmarshal[user]([]byte(`{"name":"alice","age":1}`), DisallowUnknownFields()).IsOk() {
+		t.Error("Unmarshal failed to disallow unknown fields")
+	}
+	if !errors.Is(Unmarshal[user]([]byte(`{} {}`)).UnwrapError(), ErrTrailingData) {
+		t.Error("Unmarshal failed to reject trailing data")
+	}
+}
+
+func TestDecodeReader(t *testing.T) {
+	r := strings.NewReader(`{"name":"a"}`)
+	if DecodeReader[user](r).Unwrap().Name != "a" || DecodeReader[user](strings.NewReader(`{`)).IsOk() {
+		t.Error("DecodeReader failed")
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	if string(*Marshal(user{Name: "a"}).Unwrap()) != `{"name":"a"}` || Marshal(func() {}).IsOk() {
+		t.Error("Marshal failed")
+	}
+}
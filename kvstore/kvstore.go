@@ -0,0 +1,42 @@
+package kvstore
+
+import (
+	"context"
+	"time"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// Store is a key-value store, such as a cache backed by Redis or memcached.
+// A missing key is an [`Ok`] of [`None`], while an [`Err`] reports a failure of the store itself.
+type Store[K comparable, V any] interface {
+	// Get returns the value of the key, or [`None`] if it is missing or expired.
+	Get(ctx context.Context, k K) *result.Result[option.Option[V]]
+	// Set sets the value of the key, expiring after `ttl` unless it is 0.
+	Set(ctx context.Context, k K, v V, ttl time.Duration) *result.Result[struct{}]
+	// Delete deletes the key, deleting a missing key is not an error.
+	Delete(ctx context.Context, k K) *result.Result[struct{}]
+}
+
+// GetOrLoad returns the value of the key if present in the store. Otherwise calls `loader`
+// and stores its [`Ok`] value for `ttl`. An [`Err`] of the loader is returned without being stored,
+// and a failure to store a loaded value is returned as an [`Err`].
+func GetOrLoad[K comparable, V any](ctx context.Context, s Store[K, V], k K, ttl time.Duration, loader func(context.Context, K) *result.Result[V]) *result.Result[V] {
+	cached := s.Get(ctx, k)
+	if cached.IsErr() {
+		return result.Err[V](cached.UnwrapError())
+	}
+	if o := cached.Unwrap(); o.IsSome() {
+		return result.Ok(o.UnwrapOrDefault())
+	}
+
+	r := loader(ctx, k)
+	if !r.IsOkAndNotNil() {
+		return r
+	}
+	if set := s.Set(ctx, k, *r.Unwrap(), ttl); set.IsErr() {
+		return result.Err[V](set.UnwrapError())
+	}
+	return r
+}
@@ -0,0 +1,33 @@
+package kvstore
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+func TestGetOrLoad(t *testing.T) {
+	ctx := context.Background()
+	var s Store[string, int] = NewMemory[string, int]()
+	calls := 0
+	loader := func(ctx context.Context, k string) *result.Result[int] {
+		calls++
+		if k == "bad" {
+			return result.Err[int](errors.New("boom"))
+		}
+		n := len(k)
+		return result.Ok(&n)
+	}
+
+	GetOrLoad(ctx, s, "abc", time.Hour, loader)
+	if *GetOrLoad(ctx, s, "abc", time.Hour, loader).Unwrap() != 3 || calls != 1 {
+		t.Error("GetOrLoad failed")
+	}
+	GetOrLoad(ctx, s, "bad", time.Hour, loader)
+	if GetOrLoad(ctx, s, "bad", time.Hour, loader).IsOk() || calls != 3 {
+		t.Error("GetOrLoad stored an Err")
+	}
+}
@@ -0,0 +1,59 @@
+package kvstore
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+type memoryEntry[V any] struct {
+	v       V
+	expires time.Time
+}
+
+// Memory is an in-memory Store, it never fails.
+// Expired entries are dropped when they are read.
+type Memory[K comparable, V any] struct {
+	mu sync.Mutex
+	m  map[K]memoryEntry[V]
+}
+
+// NewMemory creates an empty in-memory store.
+func NewMemory[K comparable, V any]() *Memory[K, V] {
+	return &Memory[K, V]{m: make(map[K]memoryEntry[V])}
+}
+
+func (s *Memory[K, V]) Get(ctx context.Context, k K) *result.Result[option.Option[V]] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e, ok := s.m[k]
+	if !ok {
+		return result.Ok(option.None[V]())
+	}
+	if !e.expires.IsZero() && time.Now().After(e.expires) {
+		delete(s.m, k)
+		return result.Ok(option.None[V]())
+	}
+	return result.Ok(option.Some(&e.v))
+}
+
+func (s *Memory[K, V]) Set(ctx context.Context, k K, v V, ttl time.Duration) *result.Result[struct{}] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	e := memoryEntry[V]{v: v}
+	if ttl > 0 {
+		e.expires = time.Now().Add(ttl)
+	}
+	s.m[k] = e
+	return result.Ok(&struct{}{})
+}
+
+func (s *Memory[K, V]) Delete(ctx context.Context, k K) *result.Result[struct{}] {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.m, k)
+	return result.Ok(&struct{}{})
+}
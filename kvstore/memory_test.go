@@ -0,0 +1,25 @@
+package kvstore
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMemory(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemory[string, int]()
+	if !s.Get(ctx, "a").Unwrap().IsNone() {
+		t.Error("Get failed")
+	}
+	s.Set(ctx, "a", 1, 0)
+	s.Set(ctx, "b", 2, time.Nanosecond)
+	time.Sleep(time.Millisecond)
+	if *s.Get(ctx, "a").Unwrap().Unwrap("") != 1 || !s.Get(ctx, "b").Unwrap().IsNone() {
+		t.Error("Set failed")
+	}
+	s.Delete(ctx, "a")
+	if !s.Get(ctx, "a").Unwrap().IsNone() {
+		t.Error("Delete failed")
+	}
+}
@@ -0,0 +1,80 @@
+package mapsx
+
+import (
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// Get returns the value of the key, or [`None`] if the key is absent.
+func Get[K comparable, V any](m map[K]V, k K) *option.Option[V] {
+	v, ok := m[k]
+	if !ok {
+		return option.None[V]()
+	}
+	return option.Some(&v)
+}
+
+// GetAs returns the value of the key if it is present and of type `T`, otherwise [`None`].
+// Nested maps can be traversed by chaining GetAs with `option.AndThen`.
+func GetAs[T any, K comparable](m map[K]any, k K) *option.Option[T] {
+	v, ok := m[k].(T)
+	if !ok {
+		return option.None[T]()
+	}
+	return option.Some(&v)
+}
+
+// GetPath walks the nested `map[string]any` values along the keys and returns
+// the last value if it is present and of type `T`, otherwise [`None`].
+func GetPath[T any](m map[string]any, keys ...string) *option.Option[T] {
+	if len(keys) == 0 {
+		return option.None[T]()
+	}
+	for _, k := range keys[:len(keys)-1] {
+		next, ok := m[k].(map[string]any)
+		if !ok {
+			return option.None[T]()
+		}
+		m = next
+	}
+	return GetAs[T](m, keys[len(keys)-1])
+}
+
+// GetOrInsertWith returns the value of the key, inserting the result of `f` first if the key is absent.
+func GetOrInsertWith[K comparable, V any](m map[K]V, k K, f func() V) V {
+	if v, ok := m[k]; ok {
+		return v
+	}
+	v := f()
+	m[k] = v
+	return v
+}
+
+// GetOrInsertResult returns an [`Ok`] of the value of the key if present. Otherwise calls `f`,
+// inserts its [`Ok`] value and returns it, or returns its [`Err`] leaving the map untouched.
+// A nil [`Ok`] value inserts the zero value of `V`.
+func GetOrInsertResult[K comparable, V any](m map[K]V, k K, f func() *result.Result[V]) *result.Result[V] {
+	if v, ok := m[k]; ok {
+		return result.Ok(&v)
+	}
+	r := f()
+	if r.IsErr() {
+		return r
+	}
+	var v V
+	if p := r.Unwrap(); p != nil {
+		v = *p
+	}
+	m[k] = v
+	return result.Ok(&v)
+}
+
+// Pop deletes the key and returns its former value, or [`None`] if the key was absent.
+func Pop[K comparable, V any](m map[K]V, k K) *option.Option[V] {
+	v, ok := m[k]
+	if !ok {
+		return option.None[V]()
+	}
+	delete(m, k)
+	return option.Some(&v)
+}
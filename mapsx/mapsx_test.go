@@ -0,0 +1,65 @@
+package mapsx
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+func TestGet(t *testing.T) {
+	m := map[string]int{"a": 1}
+	if *Get(m, "a").Unwrap("") != 1 || Get(m, "b").IsSome() {
+		t.Error("Get failed")
+	}
+}
+
+func TestGetAs(t *testing.T) {
+	doc := map[string]any{
+		"user": map[string]any{
+			"name": "alice",
+			"age":  30,
+		},
+	}
+	name := option.AndThen(GetAs[map[string]any](doc, "user"), func(u *map[string]any) *option.Option[string] {
+		return GetAs[string](*u, "name")
+	})
+	if *name.Unwrap("") != "alice" {
+		t.Error("GetAs failed")
+	}
+	if *GetPath[int](doc, "user", "age").Unwrap("") != 30 {
+		t.Error("GetPath failed")
+	}
+	if GetPath[string](doc, "user", "age").IsSome() || GetPath[string](doc, "group", "name").IsSome() {
+		t.Error("GetPath failed")
+	}
+}
+
+func TestGetOrInsert(t *testing.T) {
+	m := map[string][]int{}
+	GetOrInsertWith(m, "a", func() []int { return []int{1} })
+	if v := GetOrInsertWith(m, "a", func() []int { return nil }); len(v) != 1 {
+		t.Error("GetOrInsertWith failed")
+	}
+
+	n := map[string]int{}
+	r := GetOrInsertResult(n, "a", func() *result.Result[int] {
+		return result.Err[int](errors.New("boom"))
+	})
+	if r.IsOk() || len(n) != 0 {
+		t.Error("GetOrInsertResult failed")
+	}
+	x := 2
+	GetOrInsertResult(n, "a", func() *result.Result[int] { return result.Ok(&x) })
+	if n["a"] != 2 {
+		t.Error("GetOrInsertResult failed")
+	}
+}
+
+func TestPop(t *testing.T) {
+	m := map[string]int{"a": 1}
+	if *Pop(m, "a").Unwrap("") != 1 || len(m) != 0 || Pop(m, "a").IsSome() {
+		t.Error("Pop failed")
+	}
+}
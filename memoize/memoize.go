@@ -0,0 +1,170 @@
+package memoize
+
+import (
+	"sync"
+	"time"
+
+	"github.com/yuanzicheng/go-result-and-option/internal/peek"
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+type config struct {
+	ttl         time.Duration
+	maxSize     int
+	negative    bool
+	negativeTTL time.Duration
+}
+
+// Opt configures the cache of a memoized function.
+type Opt func(*config)
+
+// WithTTL expires cached values after `d`. Values never expire by default.
+func WithTTL(d time.Duration) Opt {
+	return func(c *config) {
+		c.ttl = d
+	}
+}
+
+// WithMaxSize bounds the number of cached keys, evicting expired entries
+// first, then the oldest ones. The cache is unbounded by default.
+func WithMaxSize(n int) Opt {
+	return func(c *config) {
+		c.maxSize = n
+	}
+}
+
+// WithNegative caches negative results ([`Err`] or [`None`]) for `ttl`, 0 meaning forever.
+// Negative results are not cached by default, so the next call retries.
+func WithNegative(ttl time.Duration) Opt {
+	return func(c *config) {
+		c.negative = true
+		c.negativeTTL = ttl
+	}
+}
+
+// Func returns a thread-safe cached version of `f`.
+// Concurrent calls for a missing key may call `f` more than once.
+// Every call returns a new result holding its own copy of the cached value, so callers
+// can't modify the cache; an [`Err`] served from the cache calls the error hook like Err.
+func Func[K comparable, V any](f func(K) *result.Result[V], opts ...Opt) func(K) *result.Result[V] {
+	c := newCache[K, outcome[V]](opts)
+	return func(k K) *result.Result[V] {
+		if o, ok := c.get(k); ok {
+			if o.err != nil {
+				return result.Err[V](o.err)
+			}
+			return result.Ok(copied(o.value))
+		}
+		r := f(k)
+		v, err := peek.Result(r)
+		c.put(k, outcome[V]{value: copied(v.(*V)), err: err}, err == nil)
+		return r
+	}
+}
+
+// FuncOption returns a thread-safe cached version of `f`.
+// Concurrent calls for a missing key may call `f` more than once.
+// Every call returns a new option holding its own copy of the cached value, so callers can't modify the cache.
+func FuncOption[K comparable, V any](f func(K) *option.Option[V], opts ...Opt) func(K) *option.Option[V] {
+	c := newCache[K, *V](opts)
+	return func(k K) *option.Option[V] {
+		if v, ok := c.get(k); ok {
+			return option.New(copied(v))
+		}
+		o := f(k)
+		v := peek.Option(o).(*V)
+		c.put(k, copied(v), v != nil)
+		return o
+	}
+}
+
+// outcome is a cached result.
+type outcome[V any] struct {
+	value *V
+	err   error
+}
+
+// copied returns a pointer to a copy of `*p`, or nil if `p` is nil.
+func copied[V any](p *V) *V {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
+type entry[R any] struct {
+	r       R
+	expires time.Time
+	seq     uint64
+}
+
+type cache[K comparable, R any] struct {
+	cfg config
+	mu  sync.Mutex
+	m   map[K]entry[R]
+	seq uint64
+}
+
+func newCache[K comparable, R any](opts []Opt) *cache[K, R] {
+	c := &cache[K, R]{m: make(map[K]entry[R])}
+	for _, opt := range opts {
+		opt(&c.cfg)
+	}
+	return c
+}
+
+func (c *cache[K, R]) get(k K) (R, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.m[k]
+	if !ok || expired(e.expires, time.Now()) {
+		var zero R
+		return zero, false
+	}
+	return e.r, true
+}
+
+func (c *cache[K, R]) put(k K, r R, positive bool) {
+	ttl := c.cfg.ttl
+	if !positive {
+		if !c.cfg.negative {
+			return
+		}
+		ttl = c.cfg.negativeTTL
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	now := time.Now()
+	if _, ok := c.m[k]; !ok && c.cfg.maxSize > 0 && len(c.m) >= c.cfg.maxSize {
+		c.evict(now)
+	}
+	var expires time.Time
+	if ttl > 0 {
+		expires = now.Add(ttl)
+	}
+	c.seq++
+	c.m[k] = entry[R]{r: r, expires: expires, seq: c.seq}
+}
+
+// evict removes an expired entry, or the oldest one if none has expired.
+func (c *cache[K, R]) evict(now time.Time) {
+	var oldest K
+	var seq uint64
+	for k, e := range c.m {
+		if expired(e.expires, now) {
+			delete(c.m, k)
+			return
+		}
+		if seq == 0 || e.seq < seq {
+			oldest, seq = k, e.seq
+		}
+	}
+	delete(c.m, oldest)
+}
+
+func expired(expires, now time.Time) bool {
+	return !expires.IsZero() && now.After(expires)
+}
@@ -0,0 +1,68 @@
+package memoize
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+func TestFunc(t *testing.T) {
+	calls := 0
+	f := Func(func(k int) *result.Result[int] {
+		calls++
+		if k < 0 {
+			return result.Err[int](errors.New("negative"))
+		}
+		return result.Ok(&k)
+	}, WithMaxSize(2))
+
+	f(1)
+	f(1)
+	f(-1)
+	f(-1)
+	if calls != 3 {
+		t.Errorf("Func called f %d times", calls)
+	}
+
+	f(2)
+	f(3)
+	f(1)
+	if calls != 6 {
+		t.Errorf("Func failed to evict, called f %d times", calls)
+	}
+}
+
+func TestFuncOption(t *testing.T) {
+	calls := 0
+	f := FuncOption(func(k int) *option.Option[int] {
+		calls++
+		return option.None[int]()
+	}, WithNegative(0), WithTTL(time.Hour))
+
+	f(1)
+	f(1)
+	if calls != 1 {
+		t.Errorf("FuncOption called f %d times", calls)
+	}
+}
+
+func TestFuncCopies(t *testing.T) {
+	f := Func(func(k int) *result.Result[int] { return result.Ok(&k) })
+	*f(1).Unwrap() = 10
+	r := f(1)
+	*r.Unwrap() = 20
+	r.Release()
+	if v := f(1); *v.Unwrap() != 1 {
+		t.Errorf("changing a returned result changed the cache to %d", *v.Unwrap())
+	}
+
+	g := FuncOption(func(k int) *option.Option[int] { return option.Some(&k) })
+	g(1).Take()
+	*g(1).Unwrap("") = 10
+	if v := g(1); *v.Unwrap("") != 1 {
+		t.Errorf("changing a returned option changed the cache to %d", *v.Unwrap(""))
+	}
+}
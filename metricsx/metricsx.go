@@ -0,0 +1,85 @@
+package metricsx
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/internal/peek"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// Outcome is the outcome of an instrumented call.
+type Outcome string
+
+const (
+	OutcomeOk  Outcome = "ok"
+	OutcomeErr Outcome = "err"
+)
+
+// Observation is a call measured by Instrument.
+type Observation struct {
+	Name    string
+	Outcome Outcome
+	// Kind is the kind of the error of an [`Err`], empty for an [`Ok`] or an error without kind.
+	Kind     errkind.Kind
+	Duration time.Duration
+}
+
+// Recorder records observations, e.g. by incrementing a counter and observing
+// a histogram labeled with the name, outcome and kind.
+type Recorder interface {
+	Record(ctx context.Context, o Observation)
+}
+
+// RecorderFunc is a function implementing Recorder.
+type RecorderFunc func(ctx context.Context, o Observation)
+
+func (f RecorderFunc) Record(ctx context.Context, o Observation) {
+	f(ctx, o)
+}
+
+var recorder atomic.Pointer[Recorder]
+
+// SetRecorder sets the recorder used by Instrument, replacing any previous one.
+// A nil recorder disables recording.
+func SetRecorder(r Recorder) {
+	if r == nil {
+		recorder.Store(nil)
+		return
+	}
+	recorder.Store(&r)
+}
+
+// Instrument returns a function calling `f` and recording the outcome and duration
+// of every call with the recorder set by SetRecorder at the time of the call.
+func Instrument[T any](name string, f func(context.Context) *result.Result[T]) func(context.Context) *result.Result[T] {
+	return func(ctx context.Context) *result.Result[T] {
+		r := recorder.Load()
+		if r == nil {
+			return f(ctx)
+		}
+		return record(ctx, *r, name, f)
+	}
+}
+
+// InstrumentWith is like Instrument with a given recorder.
+func InstrumentWith[T any](rec Recorder, name string, f func(context.Context) *result.Result[T]) func(context.Context) *result.Result[T] {
+	return func(ctx context.Context) *result.Result[T] {
+		return record(ctx, rec, name, f)
+	}
+}
+
+func record[T any](ctx context.Context, rec Recorder, name string, f func(context.Context) *result.Result[T]) *result.Result[T] {
+	start := time.Now()
+	res := f(ctx)
+	o := Observation{Name: name, Outcome: OutcomeOk, Duration: time.Since(start)}
+	// Recording only observes the result, the caller still has to consume it.
+	if _, err := peek.Result(res); err != nil {
+		o.Outcome = OutcomeErr
+		o.Kind = errkind.KindOf(err)
+	}
+	rec.Record(ctx, o)
+	return res
+}
@@ -0,0 +1,38 @@
+package metricsx
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+func TestInstrument(t *testing.T) {
+	var obs []Observation
+	SetRecorder(RecorderFunc(func(ctx context.Context, o Observation) { obs = append(obs, o) }))
+	defer SetRecorder(nil)
+
+	fail := true
+	f := Instrument("get_user", func(ctx context.Context) *result.Result[int] {
+		if fail {
+			return result.Err[int](errkind.Wrap(errkind.NotFound, errors.New("no such user")))
+		}
+		x := 1
+		return result.Ok(&x)
+	})
+	f(context.Background())
+	fail = false
+	f(context.Background())
+
+	if len(obs) != 2 || obs[0].Name != "get_user" || obs[0].Outcome != OutcomeErr || obs[0].Kind != errkind.NotFound || obs[1].Outcome != OutcomeOk || obs[1].Kind != "" {
+		t.Errorf("Instrument failed: %+v", obs)
+	}
+
+	SetRecorder(nil)
+	f(context.Background())
+	if len(obs) != 2 {
+		t.Error("Instrument recorded without recorder")
+	}
+}
@@ -0,0 +1,87 @@
+// Package msgx decodes the messages of queue consumers into results, decoding failures
+// being tagged as poison messages.
+package msgx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"mime"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// Codec decodes the payloads of a content type.
+type Codec interface {
+	// ContentType is the media type of the payloads, e.g. `application/json`.
+	ContentType() string
+	Unmarshal(data []byte, v any) error
+}
+
+type codec struct {
+	contentType string
+	unmarshal   func([]byte, any) error
+}
+
+func (c *codec) ContentType() string {
+	return c.contentType
+}
+
+func (c *codec) Unmarshal(data []byte, v any) error {
+	return c.unmarshal(data, v)
+}
+
+// NewCodec returns a codec of the content type, e.g. `NewCodec("application/msgpack", msgpack.Unmarshal)`.
+func NewCodec(contentType string, unmarshal func(data []byte, v any) error) Codec {
+	return &codec{contentType: contentType, unmarshal: unmarshal}
+}
+
+// JSON is the codec of `application/json`, the default codec.
+var JSON = NewCodec("application/json", json.Unmarshal)
+
+// ErrContentType is the error of messages of a content type without codec.
+var ErrContentType = errors.New("msgx: unsupported content type")
+
+// Decode decodes the payload with the first codec succeeding, JSON by default.
+// The error of a payload no codec decodes is tagged with `errkind.Invalid`, see IsPoison.
+func Decode[T any](payload []byte, codecs ...Codec) *result.Result[T] {
+	if len(codecs) == 0 {
+		codecs = []Codec{JSON}
+	}
+	var errs []error
+	for _, c := range codecs {
+		v := new(T)
+		err := c.Unmarshal(payload, v)
+		if err == nil {
+			return result.Ok(v)
+		}
+		errs = append(errs, fmt.Errorf("%s: %w", c.ContentType(), err))
+	}
+	return result.Err[T](errkind.Wrap(errkind.Invalid, errors.Join(errs...)))
+}
+
+// DecodeContentType decodes the payload with the codec of the content type, ignoring its parameters,
+// among the codecs, JSON by default. The errors of undecodable payloads and of content types
+// without codec are tagged with `errkind.Invalid`, see IsPoison.
+func DecodeContentType[T any](contentType string, payload []byte, codecs ...Codec) *result.Result[T] {
+	if len(codecs) == 0 {
+		codecs = []Codec{JSON}
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return result.Err[T](errkind.Wrap(errkind.Invalid, fmt.Errorf("%w %q: %w", ErrContentType, contentType, err)))
+	}
+	for _, c := range codecs {
+		if c.ContentType() == mediaType {
+			return Decode[T](payload, c)
+		}
+	}
+	return result.Err[T](errkind.Wrap(errkind.Invalid, fmt.Errorf("%w %q", ErrContentType, mediaType)))
+}
+
+// IsPoison reports whether the error is the error of a message that can never be decoded,
+// which should be dead-lettered rather than retried.
+func IsPoison(err error) bool {
+	return errors.Is(err, errkind.Invalid)
+}
@@ -0,0 +1,39 @@
+package msgx
+
+import (
+	"encoding/xml"
+	"errors"
+	"testing"
+)
+
+type order struct {
+	ID int `json:"id" xml:"id"`
+}
+
+var xmlCodec = NewCodec("application/xml", xml.Unmarshal)
+
+func TestDecode(t *testing.T) {
+	if Decode[order]([]byte(`{"id": 1}`)).Unwrap().ID != 1 {
+		t.Error("Decode failed")
+	}
+	if Decode[order]([]byte(`<order><id>2</id></order>`), JSON, xmlCodec).Unwrap().ID != 2 {
+		t.Error("Decode failed to fall back to the next codec")
+	}
+	if err := Decode[order]([]byte(`garbage`)).UnwrapError(); !IsPoison(err) {
+		t.Errorf("Decode failed to tag a poison message: %v", err)
+	}
+}
+
+func TestDecodeContentType(t *testing.T) {
+	r := DecodeContentType[order]("application/xml; charset=utf-8", []byte(`<order><id>3</id></order>`), JSON, xmlCodec)
+	if r.Unwrap().ID != 3 {
+		t.Error("DecodeContentType failed")
+	}
+	err := DecodeContentType[order]("application/msgpack", []byte{0x80}).UnwrapError()
+	if !errors.Is(err, ErrContentType) || !IsPoison(err) {
+		t.Errorf("DecodeContentType failed on an unsupported content type: %v", err)
+	}
+	if !IsPoison(DecodeContentType[order]("", nil).UnwrapError()) {
+		t.Error("DecodeContentType failed on a missing content type")
+	}
+}
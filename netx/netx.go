@@ -0,0 +1,57 @@
+package netx
+
+import (
+	"context"
+	"net"
+	"time"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+	"github.com/yuanzicheng/go-result-and-option/timeoutx"
+)
+
+// Dial connects to the address on the named network, as `net.Dialer.DialContext` does.
+func Dial(ctx context.Context, network, addr string) *result.Result[net.Conn] {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, network, addr)
+	if err != nil {
+		return result.Err[net.Conn](err)
+	}
+	return result.Ok(&conn)
+}
+
+// Dialer returns Dial bound to the address, so it can be passed to
+// combinators like `timeoutx.With` or `resilience.Fallbacks`.
+func Dialer(network, addr string) func(context.Context) *result.Result[net.Conn] {
+	return func(ctx context.Context) *result.Result[net.Conn] {
+		return Dial(ctx, network, addr)
+	}
+}
+
+// DialTimeout is like Dial, but gives up after `d` using `timeoutx.WithLate`,
+// closing connections established after the timeout.
+func DialTimeout(ctx context.Context, d time.Duration, network, addr string) *result.Result[net.Conn] {
+	return timeoutx.WithLate(ctx, d, Dialer(network, addr), func(r *result.Result[net.Conn]) {
+		r.Inspect(func(conn *net.Conn) {
+			(*conn).Close()
+		})
+	})
+}
+
+// Listen announces on the local network address, as `net.ListenConfig.Listen` does.
+func Listen(ctx context.Context, network, addr string) *result.Result[net.Listener] {
+	var lc net.ListenConfig
+	l, err := lc.Listen(ctx, network, addr)
+	if err != nil {
+		return result.Err[net.Listener](err)
+	}
+	return result.Ok(&l)
+}
+
+// ResolveTCPAddr returns the address of a TCP end point, as `net.ResolveTCPAddr` does.
+func ResolveTCPAddr(network, addr string) *result.Result[net.TCPAddr] {
+	a, err := net.ResolveTCPAddr(network, addr)
+	if err != nil {
+		return result.Err[net.TCPAddr](err)
+	}
+	return result.Ok(a)
+}
@@ -0,0 +1,31 @@
+package netx
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestDial(t *testing.T) {
+	ctx := context.Background()
+	l := *Listen(ctx, "tcp", "127.0.0.1:0").Unwrap()
+	defer l.Close()
+
+	addr := l.Addr().String()
+	if ResolveTCPAddr("tcp", addr).Unwrap().Port == 0 {
+		t.Error("ResolveTCPAddr failed")
+	}
+
+	conn := DialTimeout(ctx, time.Second, "tcp", addr)
+	if conn.IsErr() {
+		t.Fatal(conn.UnwrapError())
+	}
+	(*conn.Unwrap()).Close()
+
+	if Listen(ctx, "tcp", addr).IsOk() {
+		t.Error("Listen failed on a used address")
+	}
+	if ResolveTCPAddr("bogus", addr).IsOk() {
+		t.Error("ResolveTCPAddr failed")
+	}
+}
@@ -0,0 +1,60 @@
+package observable
+
+import (
+	"context"
+	"sync"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// Observable broadcasts results to its subscribers, replaying the last
+// published result to late subscribers. The zero value is ready for use.
+type Observable[T any] struct {
+	mu   sync.Mutex
+	last *result.Result[T]
+	subs map[chan *result.Result[T]]struct{}
+}
+
+// Publish sends the result to every subscriber.
+// A subscriber that hasn't received the previous result yet only gets the latest one.
+func (o *Observable[T]) Publish(r *result.Result[T]) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.last = r
+	for ch := range o.subs {
+		send(ch, r)
+	}
+}
+
+// Subscribe returns a channel receiving the published results, starting with
+// the last one if any. The channel is closed once the context is done.
+func (o *Observable[T]) Subscribe(ctx context.Context) <-chan *result.Result[T] {
+	ch := make(chan *result.Result[T], 1)
+	o.mu.Lock()
+	if o.subs == nil {
+		o.subs = make(map[chan *result.Result[T]]struct{})
+	}
+	o.subs[ch] = struct{}{}
+	if o.last != nil {
+		ch <- o.last
+	}
+	o.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		o.mu.Lock()
+		defer o.mu.Unlock()
+		delete(o.subs, ch)
+		close(ch)
+	}()
+	return ch
+}
+
+// send replaces any pending result of `ch` with `r`, it must be called with the lock held.
+func send[T any](ch chan *result.Result[T], r *result.Result[T]) {
+	select {
+	case <-ch:
+	default:
+	}
+	ch <- r
+}
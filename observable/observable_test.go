@@ -0,0 +1,30 @@
+package observable
+
+import (
+	"context"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+func TestObservable(t *testing.T) {
+	var o Observable[int]
+	x, y := 1, 2
+	o.Publish(result.Ok(&x))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := o.Subscribe(ctx)
+	if r := <-ch; *r.Unwrap() != 1 {
+		t.Error("Subscribe failed to replay")
+	}
+
+	o.Publish(result.Ok(&x))
+	o.Publish(result.Ok(&y))
+	if r := <-ch; *r.Unwrap() != 2 {
+		t.Error("Publish failed")
+	}
+
+	cancel()
+	for range ch {
+	}
+}
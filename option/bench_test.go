@@ -0,0 +1,123 @@
+package option
+
+import "testing"
+
+var (
+	benchValue = 1
+	benchSome  = Some(&benchValue)
+	benchNone  = None[int]()
+)
+
+func inc(x *int) *int {
+	return x
+}
+
+func incOption(x *int) *Option[int] {
+	return benchSome
+}
+
+// TestAllocBudget enforces the allocations of the hot paths. The [`Some`] path of Map
+// allocates the mapped option itself, which the pointer API can't avoid.
+func TestAllocBudget(t *testing.T) {
+	if testing.CoverMode() != "" {
+		t.Skip("allocations are not representative with coverage")
+	}
+	budgets := []struct {
+		name   string
+		budget float64
+		f      func()
+	}{
+		{"Map/Some", 1, func() { Map(benchSome, inc) }},
+		{"AndThen/Some", 0, func() { AndThen(benchSome, incOption) }},
+		{"UnwrapOr/Some", 0, func() { benchSome.UnwrapOr(&benchValue) }},
+		{"UnwrapOr/None", 0, func() { benchNone.UnwrapOr(&benchValue) }},
+		{"MapOr/Some", 0, func() { MapOr(benchSome, &benchValue, inc) }},
+		{"Or/Some", 0, func() { benchSome.Or(benchNone) }},
+		{"IsSome", 0, func() { benchSome.IsSome() }},
+	}
+	for _, b := range budgets {
+		if allocs := testing.AllocsPerRun(100, b.f); allocs > b.budget {
+			t.Errorf("%s allocates %v times, budget is %v", b.name, allocs, b.budget)
+		}
+	}
+}
+
+func BenchmarkSome(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Some(&benchValue)
+	}
+}
+
+func BenchmarkMap(b *testing.B) {
+	b.Run("Some", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			Map(benchSome, inc)
+		}
+	})
+	b.Run("None", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			Map(benchNone, inc)
+		}
+	})
+}
+
+func BenchmarkMapOr(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		MapOr(benchSome, &benchValue, inc)
+	}
+}
+
+func BenchmarkMapOrElse(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		MapOrElse(benchNone, func() *int { return &benchValue }, inc)
+	}
+}
+
+func BenchmarkAnd(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		And(benchSome, benchSome)
+	}
+}
+
+func BenchmarkAndThen(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		AndThen(benchSome, incOption)
+	}
+}
+
+func BenchmarkOr(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchNone.Or(benchSome)
+	}
+}
+
+func BenchmarkOrElse(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchNone.OrElse(func() *Option[int] { return benchSome })
+	}
+}
+
+func BenchmarkXOr(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchSome.XOr(benchNone)
+	}
+}
+
+func BenchmarkUnwrapOr(b *testing.B) {
+	b.Run("Some", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			benchSome.UnwrapOr(&benchValue)
+		}
+	})
+	b.Run("None", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			benchNone.UnwrapOr(&benchValue)
+		}
+	})
+}
+
+func BenchmarkInspect(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchSome.Inspect(func(*int) {})
+	}
+}
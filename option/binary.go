@@ -0,0 +1,60 @@
+package option
+
+import (
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// MarshalBinary encodes the option as a presence byte, 0 for [`None`] and 1 for [`Some`],
+// followed by the value encoded by its `encoding.BinaryMarshaler` implementation
+// or by `binary.Append` in big-endian order for fixed-size types.
+func (o *Option[T]) MarshalBinary() ([]byte, error) {
+	if o.value == nil {
+		return []byte{0}, nil
+	}
+	if m, ok := any(o.value).(encoding.BinaryMarshaler); ok {
+		b, err := m.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte{1}, b...), nil
+	}
+	return binary.Append([]byte{1}, binary.BigEndian, o.value)
+}
+
+// UnmarshalBinary decodes an option encoded by MarshalBinary.
+func (o *Option[T]) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return errors.New("option: empty binary data")
+	}
+	switch data[0] {
+	case 0:
+		if len(data) > 1 {
+			return errors.New("option: trailing binary data after None")
+		}
+		o.value = nil
+		return nil
+	case 1:
+	default:
+		return fmt.Errorf("option: invalid presence byte %d", data[0])
+	}
+
+	v := new(T)
+	if u, ok := any(v).(encoding.BinaryUnmarshaler); ok {
+		if err := u.UnmarshalBinary(data[1:]); err != nil {
+			return err
+		}
+	} else {
+		n, err := binary.Decode(data[1:], binary.BigEndian, v)
+		if err != nil {
+			return err
+		}
+		if n != len(data)-1 {
+			return errors.New("option: trailing binary data after value")
+		}
+	}
+	o.value = v
+	return nil
+}
@@ -0,0 +1,38 @@
+package option
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestBinary(t *testing.T) {
+	type point struct{ X, Y int32 }
+	p := point{1, 2}
+	b, err := Some(&p).MarshalBinary()
+	if err != nil || !bytes.Equal(b, []byte{1, 0, 0, 0, 1, 0, 0, 0, 2}) {
+		t.Errorf("MarshalBinary failed: %v %v", b, err)
+	}
+	var o Option[point]
+	if err := o.UnmarshalBinary(b); err != nil || *o.Unwrap("") != p {
+		t.Error("UnmarshalBinary failed")
+	}
+
+	b, _ = None[point]().MarshalBinary()
+	if err := o.UnmarshalBinary(b); err != nil || o.IsSome() {
+		t.Error("UnmarshalBinary failed on None")
+	}
+
+	now := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	b, _ = Some(&now).MarshalBinary()
+	var ot Option[time.Time]
+	if err := ot.UnmarshalBinary(b); err != nil || !ot.Unwrap("").Equal(now) {
+		t.Error("UnmarshalBinary failed on BinaryUnmarshaler")
+	}
+
+	for _, data := range [][]byte{nil, {2}, {0, 1}, {1, 0, 0, 0, 1, 0, 0, 0, 2, 3}} {
+		if o.UnmarshalBinary(data) == nil {
+			t.Errorf("UnmarshalBinary accepted %v", data)
+		}
+	}
+}
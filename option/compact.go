@@ -0,0 +1,56 @@
+package option
+
+// CompactOption is an option stored inline as a bare `T`, the zero value of `T` standing for [`None`].
+// It takes the size of `T` instead of a pointer to a separately allocated `T`, which matters for
+// large slices of small values, at the cost of being unable to hold the zero value as a [`Some`]:
+// `Compact(0)` is [`None`]. Only use it when the zero value is never a meaningful value.
+// The zero value is a [`None`].
+type CompactOption[T comparable] struct {
+	v T
+}
+
+// Compact returns a [`Some`] of `v`, or [`None`] if `v` is the zero value of `T`.
+func Compact[T comparable](v T) CompactOption[T] {
+	return CompactOption[T]{v: v}
+}
+
+// CompactOf converts the option, a [`Some`] of the zero value becoming [`None`].
+func CompactOf[T comparable](o *Option[T]) CompactOption[T] {
+	if v, ok := o.Deref(); ok {
+		return CompactOption[T]{v: v}
+	}
+	return CompactOption[T]{}
+}
+
+// IsSome returns `true` if the option holds a value other than the zero value.
+func (c CompactOption[T]) IsSome() bool {
+	var zero T
+	return c.v != zero
+}
+
+// IsNone returns `true` if the option holds the zero value.
+func (c CompactOption[T]) IsNone() bool {
+	return !c.IsSome()
+}
+
+// Get returns the value and `true`, or the zero value and `false` if the option is [`None`].
+func (c CompactOption[T]) Get() (T, bool) {
+	return c.v, c.IsSome()
+}
+
+// UnwrapOr returns the value, or `v` if the option is [`None`].
+func (c CompactOption[T]) UnwrapOr(v T) T {
+	if c.IsNone() {
+		return v
+	}
+	return c.v
+}
+
+// Option converts the compact option to an [`Option`] holding a copy of the value.
+func (c CompactOption[T]) Option() *Option[T] {
+	if c.IsNone() {
+		return None[T]()
+	}
+	v := c.v
+	return Some(&v)
+}
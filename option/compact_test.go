@@ -0,0 +1,28 @@
+package option
+
+import (
+	"testing"
+	"unsafe"
+)
+
+func TestCompactOption(t *testing.T) {
+	var z CompactOption[int64]
+	if !z.IsNone() || z.UnwrapOr(7) != 7 || !z.Option().IsNone() {
+		t.Error("zero value is not None")
+	}
+	c := Compact[int64](5)
+	if v, ok := c.Get(); v != 5 || !ok || *c.Option().Unwrap("") != 5 {
+		t.Error("Compact failed")
+	}
+	if Compact[int64](0).IsSome() {
+		t.Error("zero value is Some")
+	}
+
+	x, zero := int64(3), int64(0)
+	if v, _ := CompactOf(Some(&x)).Get(); v != 3 || CompactOf(Some(&zero)).IsSome() || CompactOf(None[int64]()).IsSome() {
+		t.Error("CompactOf failed")
+	}
+	if unsafe.Sizeof(c) != unsafe.Sizeof(x) {
+		t.Error("CompactOption is larger than its value")
+	}
+}
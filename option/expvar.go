@@ -0,0 +1,31 @@
+package option
+
+import (
+	"expvar"
+	"sync"
+	"sync/atomic"
+)
+
+var (
+	counting    atomic.Bool
+	counters    = new(expvar.Map).Init()
+	publishOnce sync.Once
+)
+
+// Expvar enables the counting of the options created, published as the expvar variable "option"
+// with the counters "some" and "none", and returns it.
+func Expvar() *expvar.Map {
+	publishOnce.Do(func() {
+		expvar.Publish("option", counters)
+		counting.Store(true)
+	})
+	return counters
+}
+
+func count(some bool) {
+	if some {
+		counters.Add("some", 1)
+	} else {
+		counters.Add("none", 1)
+	}
+}
@@ -0,0 +1,20 @@
+package option
+
+import (
+	"expvar"
+	"testing"
+)
+
+func TestExpvar(t *testing.T) {
+	m := Expvar()
+	if expvar.Get("option") != m || Expvar() != m {
+		t.Error("Expvar failed to publish the counters")
+	}
+	before := counters.Get("none")
+	x := 1
+	Some(&x)
+	None[int]()
+	if m.Get("some") == nil || before != nil && m.Get("none").String() == before.String() {
+		t.Errorf("Expvar failed to count: %s", m)
+	}
+}
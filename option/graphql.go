@@ -0,0 +1,68 @@
+package option
+
+import (
+	"encoding/json"
+	"io"
+	"reflect"
+)
+
+// gqlMarshaler and gqlUnmarshaler are the `graphql.Marshaler` and `graphql.Unmarshaler` interfaces of gqlgen.
+type gqlMarshaler interface {
+	MarshalGQL(w io.Writer)
+}
+
+type gqlUnmarshaler interface {
+	UnmarshalGQL(v any) error
+}
+
+// MarshalGQL implements gqlgen's `graphql.Marshaler`, so nullable GraphQL fields can be options:
+// a [`None`] writes `null`, a [`Some`] its value, through the `MarshalGQL` method of `T` if any,
+// otherwise as JSON.
+func (o Option[T]) MarshalGQL(w io.Writer) {
+	if o.value == nil {
+		io.WriteString(w, "null")
+		return
+	}
+	if m, ok := any(*o.value).(gqlMarshaler); ok {
+		m.MarshalGQL(w)
+		return
+	}
+	if m, ok := any(o.value).(gqlMarshaler); ok {
+		m.MarshalGQL(w)
+		return
+	}
+	b, err := json.Marshal(o.value)
+	if err != nil {
+		io.WriteString(w, "null")
+		return
+	}
+	w.Write(b)
+}
+
+// UnmarshalGQL implements gqlgen's `graphql.Unmarshaler`: a nil input gives a [`None`], other inputs
+// a [`Some`], through the `UnmarshalGQL` method of `*T` if any, otherwise by assignment or
+// by a JSON round trip, e.g. for the `map[string]any` of input objects.
+func (o *Option[T]) UnmarshalGQL(v any) error {
+	if v == nil {
+		o.value = nil
+		return nil
+	}
+	t := new(T)
+	if u, ok := any(t).(gqlUnmarshaler); ok {
+		if err := u.UnmarshalGQL(v); err != nil {
+			return err
+		}
+	} else if rv := reflect.ValueOf(v); rv.Type().AssignableTo(reflect.TypeFor[T]()) {
+		reflect.ValueOf(t).Elem().Set(rv)
+	} else {
+		b, err := json.Marshal(v)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(b, t); err != nil {
+			return err
+		}
+	}
+	o.value = t
+	return nil
+}
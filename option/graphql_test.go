@@ -0,0 +1,59 @@
+package option
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+)
+
+type gqlID int
+
+func (id gqlID) MarshalGQL(w io.Writer) {
+	fmt.Fprintf(w, `"ID-%d"`, int(id))
+}
+
+func (id *gqlID) UnmarshalGQL(v any) error {
+	_, err := fmt.Sscanf(v.(string), "ID-%d", (*int)(id))
+	return err
+}
+
+func TestMarshalGQL(t *testing.T) {
+	gql := func(m interface{ MarshalGQL(io.Writer) }) string {
+		var b strings.Builder
+		m.MarshalGQL(&b)
+		return b.String()
+	}
+	s := "a"
+	id := gqlID(7)
+	if gql(Some(&s)) != `"a"` || gql(None[string]()) != "null" || gql(Some(&id)) != `"ID-7"` {
+		t.Error("MarshalGQL failed")
+	}
+}
+
+func TestUnmarshalGQL(t *testing.T) {
+	var s Option[string]
+	if err := s.UnmarshalGQL("a"); err != nil || *s.Unwrap("") != "a" {
+		t.Error("UnmarshalGQL failed")
+	}
+	if err := s.UnmarshalGQL(nil); err != nil || s.IsSome() {
+		t.Error("UnmarshalGQL failed on null")
+	}
+	var id Option[gqlID]
+	if err := id.UnmarshalGQL("ID-7"); err != nil || *id.Unwrap("") != 7 {
+		t.Error("UnmarshalGQL failed through UnmarshalGQL")
+	}
+	var n Option[int]
+	if err := n.UnmarshalGQL(json.Number("3")); err != nil || *n.Unwrap("") != 3 {
+		t.Errorf("UnmarshalGQL failed to convert: %v", err)
+	}
+	type input struct{ Name string }
+	var in Option[input]
+	if err := in.UnmarshalGQL(map[string]any{"Name": "b"}); err != nil || in.Unwrap("").Name != "b" {
+		t.Error("UnmarshalGQL failed on an input object")
+	}
+	if err := n.UnmarshalGQL("x"); err == nil {
+		t.Error("UnmarshalGQL failed to reject a string")
+	}
+}
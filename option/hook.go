@@ -0,0 +1,69 @@
+package option
+
+import (
+	"context"
+	"log/slog"
+	"runtime"
+	"sync/atomic"
+)
+
+// Event is the event a [`None`] hook is called for.
+type Event int
+
+const (
+	// Created is the creation of a [`None`] by None or New.
+	Created Event = iota
+	// Inspected is the replacement of a [`None`] by a fallback value by UnwrapOr,
+	// UnwrapOrElse or UnwrapOrDefault.
+	Inspected
+)
+
+func (e Event) String() string {
+	if e == Created {
+		return "created"
+	}
+	return "inspected"
+}
+
+// Meta describes the event a [`None`] hook is called for.
+type Meta struct {
+	Event Event
+	// File and Line are the location of the call creating or inspecting the [`None`].
+	File string
+	Line int
+}
+
+var noneHook atomic.Pointer[func(Meta)]
+
+// SetNoneHook sets the hook called for every [`None`] created or inspected,
+// replacing any previous one. A nil hook removes it.
+func SetNoneHook(hook func(meta Meta)) {
+	if hook == nil {
+		noneHook.Store(nil)
+		return
+	}
+	noneHook.Store(&hook)
+}
+
+// fireNone calls the None hook, if any, with the location of the caller of the function calling fireNone.
+func fireNone(event Event) {
+	fireNoneAt(event, 3)
+}
+
+// fireNoneAt calls the None hook with the location `skip` frames up, as counted by `runtime.Caller`.
+func fireNoneAt(event Event, skip int) {
+	hook := noneHook.Load()
+	if hook == nil {
+		return
+	}
+	_, file, line, _ := runtime.Caller(skip)
+	(*hook)(Meta{Event: event, File: file, Line: line})
+}
+
+// SlogHook returns a None hook logging the events with the logger at the level.
+func SlogHook(logger *slog.Logger, level slog.Level) func(Meta) {
+	return func(meta Meta) {
+		logger.Log(context.Background(), level, "option: "+meta.Event.String()+" None",
+			slog.String("file", meta.File), slog.Int("line", meta.Line))
+	}
+}
@@ -0,0 +1,43 @@
+package option
+
+import (
+	"bytes"
+	"log/slog"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSetNoneHook(t *testing.T) {
+	var metas []Meta
+	SetNoneHook(func(meta Meta) { metas = append(metas, meta) })
+	defer SetNoneHook(nil)
+
+	x := 1
+	o := None[int]()
+	o.UnwrapOr(&x)
+	Some(&x).UnwrapOr(&x)
+	if len(metas) != 2 || metas[0].Event != Created || metas[1].Event != Inspected {
+		t.Fatalf("SetNoneHook failed: %v", metas)
+	}
+	if filepath.Base(metas[0].File) != "hook_test.go" || metas[0].Line != 17 {
+		t.Errorf("SetNoneHook failed: %v", metas[0])
+	}
+
+	SetNoneHook(nil)
+	None[int]()
+	if len(metas) != 2 {
+		t.Error("SetNoneHook failed to remove the hook")
+	}
+}
+
+func TestSlogHook(t *testing.T) {
+	var b bytes.Buffer
+	SetNoneHook(SlogHook(slog.New(slog.NewTextHandler(&b, nil)), slog.LevelInfo))
+	defer SetNoneHook(nil)
+
+	None[int]()
+	if !strings.Contains(b.String(), `msg="option: created None"`) || !strings.Contains(b.String(), "hook_test.go") {
+		t.Errorf("SlogHook failed: %s", b.String())
+	}
+}
@@ -0,0 +1,14 @@
+package option
+
+import "iter"
+
+// Coalesce returns the first [`Some`] of the sequence, or [`None`] if there is none.
+// The sequence is consumed lazily: the options after the first [`Some`] are never produced.
+func Coalesce[T any](seq iter.Seq[*Option[T]]) *Option[T] {
+	for o := range seq {
+		if o != nil && o.IsSome() {
+			return o
+		}
+	}
+	return None[T]()
+}
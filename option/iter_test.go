@@ -0,0 +1,28 @@
+package option
+
+import "testing"
+
+func TestCoalesce(t *testing.T) {
+	x := 1
+	var produced int
+	sources := []func() *Option[int]{
+		None[int],
+		func() *Option[int] { return nil },
+		func() *Option[int] { return Some(&x) },
+		func() *Option[int] { t.Error("source after the first Some was evaluated"); return None[int]() },
+	}
+	seq := func(yield func(*Option[int]) bool) {
+		for _, f := range sources {
+			produced++
+			if !yield(f()) {
+				return
+			}
+		}
+	}
+	if *Coalesce(seq).Unwrap("") != 1 || produced != 3 {
+		t.Error("Coalesce failed")
+	}
+	if !Coalesce(func(func(*Option[int]) bool) {}).IsNone() {
+		t.Error("Coalesce of an empty sequence is not None")
+	}
+}
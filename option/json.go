@@ -0,0 +1,31 @@
+package option
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// MarshalJSON encodes a [`None`] as null and a [`Some`] as its value.
+// A [`Some`] of a nil pointer, slice or map is encoded as null too.
+// Together with IsZero, a [`None`] field tagged `omitzero` is left out.
+func (o Option[T]) MarshalJSON() ([]byte, error) {
+	if o.value == nil {
+		return []byte("null"), nil
+	}
+	return json.Marshal(o.value)
+}
+
+// UnmarshalJSON decodes null as a [`None`] and any other value as a [`Some`] of it.
+// A missing member leaves the option untouched, so a zero option stays [`None`].
+func (o *Option[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		o.value = nil
+		return nil
+	}
+	v := new(T)
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+	o.value = v
+	return nil
+}
@@ -0,0 +1,76 @@
+package option
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+type dtoUser struct {
+	Name string `json:"name"`
+}
+
+type jsonDTO struct {
+	Name  Option[string]  `json:"name"`
+	Tags  Option[[]int]   `json:"tags"`
+	User  Option[dtoUser] `json:"user"`
+	Ptr   Option[*int]    `json:"ptr"`
+	Email Option[string]  `json:"email,omitzero"`
+}
+
+func TestMarshalJSON(t *testing.T) {
+	name := "a"
+	tags := []int{1, 2}
+	var nilPtr *int
+	d := jsonDTO{Name: *Some(&name), Tags: *Some(&tags), User: *Some(&dtoUser{"b"}), Ptr: *Some(&nilPtr)}
+	b, err := json.Marshal(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"name":"a","tags":[1,2],"user":{"name":"b"},"ptr":null}`; string(b) != want {
+		t.Errorf("got %s, want %s", b, want)
+	}
+	if b, _ := json.Marshal(jsonDTO{}); string(b) != `{"name":null,"tags":null,"user":null,"ptr":null}` {
+		t.Errorf("None fields encoded as %s", b)
+	}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	var d jsonDTO
+	if err := json.Unmarshal([]byte(`{"name":"a","tags":[1,2],"user":{"name":"b"},"ptr":null}`), &d); err != nil {
+		t.Fatal(err)
+	}
+	if *d.Name.Unwrap("") != "a" || !slices.Equal(*d.Tags.Unwrap(""), []int{1, 2}) || d.User.Unwrap("").Name != "b" {
+		t.Errorf("decoded %+v", d)
+	}
+	if !d.Ptr.IsNone() || !d.Email.IsNone() {
+		t.Error("null and missing members should be None")
+	}
+
+	d.Email = *Some(new(string))
+	if err := json.Unmarshal([]byte(`{"name":null}`), &d); err != nil {
+		t.Fatal(err)
+	}
+	if !d.Name.IsNone() || !d.Email.IsSome() {
+		t.Error("null should reset to None and a missing member should be left untouched")
+	}
+	if err := json.Unmarshal([]byte(`{"tags":"x"}`), &d); err == nil {
+		t.Error("a mistyped value should fail")
+	}
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	for _, o := range []*Option[[]int]{Some(&[]int{1}), Some(&[]int{}), None[[]int]()} {
+		b, err := json.Marshal(o)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got Option[[]int]
+		if err := json.Unmarshal(b, &got); err != nil {
+			t.Fatal(err)
+		}
+		if got.IsSome() != o.IsSome() || o.IsSome() && !slices.Equal(*got.Unwrap(""), *o.Unwrap("")) {
+			t.Errorf("%s did not round trip", b)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+//go:build go1.27 && goexperiment.jsonv2
+
+package option
+
+import (
+	"encoding/json/jsontext"
+	"encoding/json/v2"
+	"errors"
+)
+
+// ErrJSONNull is the error of unmarshaling a JSON null into an option with the JSONRejectNull option.
+var ErrJSONNull = errors.New("option: JSON null is not allowed")
+
+// MarshalJSONTo encodes a [`None`] as null and a [`Some`] as its value.
+// It implements the `MarshalerTo` interface of encoding/json/v2.
+func (o Option[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if o.value == nil {
+		return enc.WriteToken(jsontext.Null)
+	}
+	return json.MarshalEncode(enc, o.value)
+}
+
+// UnmarshalJSONFrom decodes null as a [`None`] and any other value as a [`Some`] of it.
+// A missing member leaves the option untouched, so a zero option stays [`None`].
+// It implements the `UnmarshalerFrom` interface of encoding/json/v2.
+func (o *Option[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if dec.PeekKind() == 'n' {
+		if _, err := dec.ReadToken(); err != nil {
+			return err
+		}
+		o.value = nil
+		return nil
+	}
+	v := new(T)
+	if err := json.UnmarshalDecode(dec, v); err != nil {
+		return err
+	}
+	o.value = v
+	return nil
+}
+
+type noneAsZero interface {
+	marshalNoneAsZero(enc *jsontext.Encoder) error
+}
+
+func (o Option[T]) marshalNoneAsZero(enc *jsontext.Encoder) error {
+	if o.value != nil {
+		return errors.ErrUnsupported
+	}
+	return json.MarshalEncode(enc, new(T))
+}
+
+type nullRejecter interface {
+	rejectNull(dec *jsontext.Decoder) error
+}
+
+func (o *Option[T]) rejectNull(dec *jsontext.Decoder) error {
+	if dec.PeekKind() == 'n' {
+		return ErrJSONNull
+	}
+	return errors.ErrUnsupported
+}
+
+// JSONNoneAsZero returns the encoding/json/v2 options marshaling a [`None`] as the zero value of `T`
+// rather than null, for consumers that can't handle null.
+func JSONNoneAsZero() json.Options {
+	return json.WithMarshalers(json.MarshalToFunc(func(enc *jsontext.Encoder, o noneAsZero) error {
+		return o.marshalNoneAsZero(enc)
+	}))
+}
+
+// JSONRejectNull returns the encoding/json/v2 options failing with ErrJSONNull when unmarshaling null
+// into an option, for APIs where a member may be missing but must not be null.
+func JSONRejectNull() json.Options {
+	return json.WithUnmarshalers(json.UnmarshalFromFunc(func(dec *jsontext.Decoder, o nullRejecter) error {
+		return o.rejectNull(dec)
+	}))
+}
@@ -0,0 +1,54 @@
+//go:build go1.27 && goexperiment.jsonv2
+
+package option
+
+import (
+	"encoding/json/v2"
+	"errors"
+	"testing"
+)
+
+type jsonUser struct {
+	Name Option[string] `json:"name"`
+	Age  Option[int]    `json:"age,omitzero"`
+}
+
+func TestJSONv2(t *testing.T) {
+	name := "a"
+	b, err := json.Marshal(jsonUser{Name: *Some(&name)})
+	if err != nil || string(b) != `{"name":"a"}` {
+		t.Errorf("Marshal = %s, %v", b, err)
+	}
+	b, err = json.Marshal(jsonUser{})
+	if err != nil || string(b) != `{"name":null}` {
+		t.Errorf("Marshal of None = %s, %v", b, err)
+	}
+
+	var u jsonUser
+	if err := json.Unmarshal([]byte(`{"name":"b","age":3}`), &u); err != nil || *u.Name.Unwrap("") != "b" || *u.Age.Unwrap("") != 3 {
+		t.Errorf("Unmarshal failed: %v", err)
+	}
+	if err := json.Unmarshal([]byte(`{"name":null}`), &u); err != nil || !u.Name.IsNone() || *u.Age.Unwrap("") != 3 {
+		t.Errorf("Unmarshal of null failed: %v", err)
+	}
+}
+
+func TestJSONv2Options(t *testing.T) {
+	b, err := json.Marshal(jsonUser{}, JSONNoneAsZero())
+	if err != nil || string(b) != `{"name":""}` {
+		t.Errorf("Marshal with JSONNoneAsZero = %s, %v", b, err)
+	}
+	name := "a"
+	b, err = json.Marshal(jsonUser{Name: *Some(&name)}, JSONNoneAsZero())
+	if err != nil || string(b) != `{"name":"a"}` {
+		t.Errorf("Marshal of Some with JSONNoneAsZero = %s, %v", b, err)
+	}
+
+	var u jsonUser
+	if err := json.Unmarshal([]byte(`{"name":null}`), &u, JSONRejectNull()); !errors.Is(err, ErrJSONNull) {
+		t.Errorf("JSONRejectNull accepted null: %v", err)
+	}
+	if err := json.Unmarshal([]byte(`{"name":"c"}`), &u, JSONRejectNull()); err != nil || *u.Name.Unwrap("") != "c" {
+		t.Errorf("JSONRejectNull failed: %v", err)
+	}
+}
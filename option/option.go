@@ -1,34 +1,40 @@
 package option
 
+import "reflect"
+
 type Option[T any] struct {
 	value *T
 }
 
 func New[T any](v *T) *Option[T] {
 	if v == nil {
-		return &Option[T]{}
+		fireNone(Created)
+		return created(&Option[T]{})
 	}
-	return &Option[T]{value: v}
+	return created(&Option[T]{value: v})
 }
 
 func Some[T any](v *T) *Option[T] {
-	return &Option[T]{value: v}
+	return created(&Option[T]{value: v})
 }
 
 func None[T any]() *Option[T] {
-	return &Option[T]{}
+	fireNone(Created)
+	return created(&Option[T]{})
 }
 
 // Map maps an `Option[T]` to `Option[U]` by applying a function to a contained value (if `Some`) or returns `None` (if `None`).
 func Map[T any, U any](o *Option[T], f func(*T) *U) *Option[U] {
+	consumed(o)
 	if o.value == nil {
-		return &Option[U]{}
+		return created(&Option[U]{})
 	}
-	return &Option[U]{value: f(o.value)}
+	return created(&Option[U]{value: f(o.value)})
 }
 
 // MapOr returns the provided fallback result (if none), or applies a function to the contained value (if any).
 func MapOr[T any, U any](o *Option[T], fallback *U, f func(*T) *U) *U {
+	consumed(o)
 	if o.value == nil {
 		return fallback
 	}
@@ -37,14 +43,35 @@ func MapOr[T any, U any](o *Option[T], fallback *U, f func(*T) *U) *U {
 
 // MapOrElse computes a default function result (if none), or applies a different function to the contained value (if any).
 func MapOrElse[T any, U any](o *Option[T], fallbackFn func() *U, f func(*T) *U) *U {
+	consumed(o)
 	if o.value == nil {
 		return fallbackFn()
 	}
 	return f(o.value)
 }
 
+// MapV is like [`Map`] for a function taking and returning values.
+func MapV[T any, U any](o *Option[T], f func(T) U) *Option[U] {
+	consumed(o)
+	if o.value == nil {
+		return created(&Option[U]{})
+	}
+	u := f(*o.value)
+	return created(&Option[U]{value: &u})
+}
+
+// MapOrV is like [`MapOr`] for a function taking and returning values.
+func MapOrV[T any, U any](o *Option[T], fallback U, f func(T) U) U {
+	consumed(o)
+	if o.value == nil {
+		return fallback
+	}
+	return f(*o.value)
+}
+
 // And returns [`None`] if the option is [`None`], otherwise returns `optb`.
 func And[T any, U any](in *Option[T], out *Option[U]) *Option[U] {
+	consumed(in)
 	if in.value == nil {
 		return nil
 	}
@@ -53,32 +80,65 @@ func And[T any, U any](in *Option[T], out *Option[U]) *Option[U] {
 
 // AndThen returns [`None`] if the option is [`None`], otherwise calls `f` with the wrapped value and returns the result.
 func AndThen[T any, U any](in *Option[T], f func(*T) *Option[U]) *Option[U] {
+	consumed(in)
 	if in.value == nil {
 		return nil
 	}
 	return f(in.value)
 }
 
+// MapSame is like [`Map`] for a function that keeps the type, so that it can be chained as a method.
+func (o *Option[T]) MapSame(f func(*T) *T) *Option[T] {
+	return Map(o, f)
+}
+
+// AndThenSame is like [`AndThen`] for a function that keeps the type, so that it can be chained as a method.
+// Unlike [`AndThen`], it returns a [`None`] rather than nil if the option is [`None`].
+func (o *Option[T]) AndThenSame(f func(*T) *Option[T]) *Option[T] {
+	consumed(o)
+	if o.value == nil {
+		return created(&Option[T]{})
+	}
+	return f(o.value)
+}
+
 // IsSomeAnd returns `true` if the option is a [`Some`].
 func (o *Option[T]) IsSome() bool {
+	consumed(o)
 	return o.value != nil
 }
 
 // IsSomeAnd returns `true` if the option is a [`Some`] and the value inside of it matches a predicate.
 func (o *Option[T]) IsSomeAnd(f func(*T) bool) bool {
+	consumed(o)
 	return o.value != nil && f(o.value)
 }
 
+// IsSomeAndNotZero returns `true` if the option is a [`Some`] and the value inside of it
+// is not the zero value of `T`, as reported by `reflect.Value.IsZero`.
+func (o *Option[T]) IsSomeAndNotZero() bool {
+	consumed(o)
+	return o.value != nil && !reflect.ValueOf(o.value).Elem().IsZero()
+}
+
 // IsSomeAnd returns `true` if the option is a [`None`].
 func (o *Option[T]) IsNone() bool {
+	consumed(o)
+	return o.value == nil
+}
+
+// IsZero returns `true` if the option is a [`None`], so that `omitzero` with encoding/json
+// and `omitempty` with BSON leave out [`None`] fields.
+func (o Option[T]) IsZero() bool {
 	return o.value == nil
 }
 
 // Expect returns the contained [`Some`] value, consuming the `self` value.
 // Panics if the value is a [`None`] with a custom panic message provided by `msg`.
 func (o *Option[T]) Expect(msg string) *T {
+	consumed(o)
 	if o.value == nil {
-		panic(msg)
+		fail(msg)
 	}
 	return o.value
 }
@@ -86,8 +146,9 @@ func (o *Option[T]) Expect(msg string) *T {
 // Unwrap returns the contained [`Some`] value, consuming the `self` value.
 // Panics if the self value equals [`None`].
 func (o *Option[T]) Unwrap(msg string) *T {
+	consumed(o)
 	if o.value == nil {
-		panic("called `Option::unwrap()` on a `None` value")
+		fail("called `Option::unwrap()` on a `None` value")
 	}
 	return o.value
 }
@@ -95,7 +156,9 @@ func (o *Option[T]) Unwrap(msg string) *T {
 // Unwrap returns the contained [`Some`] value, consuming the `self` value.
 // Panics if the self value equals [`None`].
 func (o *Option[T]) UnwrapOr(v *T) *T {
+	consumed(o)
 	if o.value == nil {
+		fireNone(Inspected)
 		return v
 	}
 	return o.value
@@ -103,7 +166,9 @@ func (o *Option[T]) UnwrapOr(v *T) *T {
 
 // UnwrapOrElse returns the contained [`Some`] value or computes it from a closure.
 func (o *Option[T]) UnwrapOrElse(f func() *T) *T {
+	consumed(o)
 	if o.value == nil {
+		fireNone(Inspected)
 		return f()
 	}
 	return o.value
@@ -111,14 +176,45 @@ func (o *Option[T]) UnwrapOrElse(f func() *T) *T {
 
 // UnwrapOrElse returns the contained [`Some`] value or a default.
 func (o *Option[T]) UnwrapOrDefault() *T {
+	consumed(o)
 	if o.value == nil {
+		fireNone(Inspected)
 		return nil
 	}
 	return o.value
 }
 
+// Ptr returns the contained [`Some`] value, or nil if the option is [`None`],
+// for APIs taking a nullable pointer.
+func (o *Option[T]) Ptr() *T {
+	consumed(o)
+	return o.value
+}
+
+// MustPtr returns the contained [`Some`] value.
+// Panics if the option is [`None`], see SetPanicHandler.
+func (o *Option[T]) MustPtr() *T {
+	consumed(o)
+	if o.value == nil {
+		fail("called `Option::MustPtr()` on a `None` value")
+	}
+	return o.value
+}
+
+// Deref returns a copy of the contained [`Some`] value and `true`,
+// or the zero value of `T` and `false` if the option is [`None`].
+func (o *Option[T]) Deref() (T, bool) {
+	consumed(o)
+	if o.value == nil {
+		var zero T
+		return zero, false
+	}
+	return *o.value, true
+}
+
 // Inspect calls the provided closure with a reference to the contained value (if [`Some`]).
 func (o *Option[T]) Inspect(f func(*T)) *Option[T] {
+	consumed(o)
 	if o.value != nil {
 		f(o.value)
 	}
@@ -127,6 +223,7 @@ func (o *Option[T]) Inspect(f func(*T)) *Option[T] {
 
 // Or returns the option if it contains a value, otherwise returns `optb`.
 func (o *Option[T]) Or(optb *Option[T]) *Option[T] {
+	consumed(o)
 	if o.value != nil {
 		return o
 	}
@@ -135,6 +232,7 @@ func (o *Option[T]) Or(optb *Option[T]) *Option[T] {
 
 // OrElse returns the option if it contains a value, otherwise calls `f` and returns the result.
 func (o *Option[T]) OrElse(f func() *Option[T]) *Option[T] {
+	consumed(o)
 	if o.value != nil {
 		return o
 	}
@@ -143,6 +241,7 @@ func (o *Option[T]) OrElse(f func() *Option[T]) *Option[T] {
 
 // XOr returns [`Some`] if exactly one of `self`, `optb` is [`Some`], otherwise returns [`None`].
 func (o *Option[T]) XOr(optb *Option[T]) *Option[T] {
+	consumed(o)
 	if o.value != nil {
 		return o
 	}
@@ -154,6 +253,7 @@ func (o *Option[T]) XOr(optb *Option[T]) *Option[T] {
 
 // Take takes the value out of the option, leaving a [`None`] in its place.
 func (o *Option[T]) Take() *T {
+	consumed(o)
 	v := o.value
 	o.value = nil
 	return v
@@ -161,6 +261,7 @@ func (o *Option[T]) Take() *T {
 
 // TakeIf takes the value out of the option, but only if the predicate evaluates to `true` to the value.
 func (o *Option[T]) TakeIf(f func(*T) bool) *T {
+	consumed(o)
 	if f(o.value) {
 		v := o.value
 		o.value = nil
@@ -178,18 +279,21 @@ func (o *Option[T]) Replace(v *T) *T {
 	return old
 }
 
-// OkOr transforms the `Option[T]` into a `Result[T]`, mapping [`Some(v)`] to [`Ok(v)`] and [`None`] to [`Err(err)`].
-// func (o *Option[T]) OkOr(err error) *result.Result[T] {
-// 	if o.value == nil {
-// 		return result.Err[T](err)
-// 	}
-// 	return result.Ok[T](o.value)
-// }
-
-// OkOrElse transforms the `Option[T]` into a `Result[T]`, mapping [`Some(v)`] to [`Ok(v)`] and [`None`] to [`Err(err())`].
-// func (o *Option[T]) OkOrElse(errFn func() error) *result.Result[T] {
-// 	if o.value == nil {
-// 		return result.Err[T](errFn())
-// 	}
-// 	return result.Ok[T](o.value)
-// }
+// Changed reports whether `a` and `b` differ: one is [`Some`] and the other [`None`],
+// or both are [`Some`] and `eq` reports their values as unequal.
+func Changed[T any](a, b *Option[T], eq func(a, b *T) bool) bool {
+	if a.value == nil || b.value == nil {
+		return (a.value == nil) != (b.value == nil)
+	}
+	return !eq(a.value, b.value)
+}
+
+// ReplaceIfChanged replaces the value by `v` only if it differs from the current one according to [`Changed`],
+// reporting whether it did. A nil `v` stands for [`None`].
+func (o *Option[T]) ReplaceIfChanged(v *T, eq func(a, b *T) bool) bool {
+	if !Changed(o, &Option[T]{value: v}, eq) {
+		return false
+	}
+	o.value = v
+	return true
+}
@@ -9,3 +9,85 @@ func TestIsOk(t *testing.T) {
 		t.Error("IsSome failed")
 	}
 }
+
+func TestIsZero(t *testing.T) {
+	x := 1
+	if !None[int]().IsZero() || Some(&x).IsZero() || !(Option[int]{}).IsZero() {
+		t.Error("IsZero failed")
+	}
+}
+
+func TestReplaceIfChanged(t *testing.T) {
+	eq := func(a, b *int) bool { return *a == *b }
+	a, b, c := 1, 1, 2
+	var o Option[int]
+	if o.ReplaceIfChanged(nil, eq) {
+		t.Error("None to None reported a change")
+	}
+	if !o.ReplaceIfChanged(&a, eq) || o.value != &a {
+		t.Error("None to Some did not replace")
+	}
+	if o.ReplaceIfChanged(&b, eq) || o.value != &a {
+		t.Error("equal value replaced")
+	}
+	if !o.ReplaceIfChanged(&c, eq) || *o.value != 2 {
+		t.Error("different value did not replace")
+	}
+	if !o.ReplaceIfChanged(nil, eq) || !o.IsNone() {
+		t.Error("Some to None did not replace")
+	}
+}
+
+func TestSame(t *testing.T) {
+	x := 3
+	inc := func(v *int) *int { n := *v + 1; return &n }
+	even := func(v *int) *Option[int] {
+		if *v%2 != 0 {
+			return None[int]()
+		}
+		return Some(v)
+	}
+	if *Some(&x).MapSame(inc).AndThenSame(even).Unwrap("") != 4 {
+		t.Error("MapSame/AndThenSame failed")
+	}
+	if o := Some(&x).AndThenSame(even).AndThenSame(even).MapSame(inc); o == nil || !o.IsNone() {
+		t.Error("None was not propagated")
+	}
+}
+
+func TestMapV(t *testing.T) {
+	x := 2
+	square := func(v int) int { return v * v }
+	if *MapV(Some(&x), square).Unwrap("") != 4 || !MapV(None[int](), square).IsNone() {
+		t.Error("MapV failed")
+	}
+	if MapOrV(Some(&x), -1, square) != 4 || MapOrV(None[int](), -1, square) != -1 {
+		t.Error("MapOrV failed")
+	}
+}
+
+func TestPtr(t *testing.T) {
+	x := 1
+	if Some(&x).Ptr() != &x || None[int]().Ptr() != nil || Some(&x).MustPtr() != &x {
+		t.Error("Ptr/MustPtr failed")
+	}
+	if v, ok := Some(&x).Deref(); v != 1 || !ok {
+		t.Error("Deref failed on Some")
+	}
+	if v, ok := None[int]().Deref(); v != 0 || ok {
+		t.Error("Deref failed on None")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("MustPtr did not panic on None")
+		}
+	}()
+	None[int]().MustPtr()
+}
+
+func TestIsSomeAndNotZero(t *testing.T) {
+	empty, name := "", "a"
+	if !Some(&name).IsSomeAndNotZero() || Some(&empty).IsSomeAndNotZero() || None[string]().IsSomeAndNotZero() {
+		t.Error("IsSomeAndNotZero failed")
+	}
+}
@@ -0,0 +1,41 @@
+package option
+
+import (
+	"errors"
+	"sync/atomic"
+)
+
+// ErrNone is the error returned by UnwrapChecked for a [`None`].
+var ErrNone = errors.New("option: called `Option::UnwrapChecked()` on a `None` value")
+
+var panicHandler atomic.Pointer[func(string)]
+
+// SetPanicHandler sets the handler called with the panic message instead of panicking by Unwrap,
+// Expect and MustPtr, replacing any previous one. A nil handler removes it, restoring the panics.
+// The handler may log the failure, panic itself or exit; if it returns, the method returns nil.
+func SetPanicHandler(h func(msg string)) {
+	if h == nil {
+		panicHandler.Store(nil)
+		return
+	}
+	panicHandler.Store(&h)
+}
+
+// fail panics with the message, or calls the panic handler instead if there is one.
+func fail(msg string) {
+	if h := panicHandler.Load(); h != nil {
+		(*h)(msg)
+		return
+	}
+	panic(msg)
+}
+
+// UnwrapChecked returns the contained [`Some`] value, or ErrNone if the option is [`None`].
+// It never panics.
+func (o *Option[T]) UnwrapChecked() (*T, error) {
+	consumed(o)
+	if o.value == nil {
+		return nil, ErrNone
+	}
+	return o.value, nil
+}
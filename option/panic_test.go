@@ -0,0 +1,28 @@
+package option
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSetPanicHandler(t *testing.T) {
+	var msgs []string
+	SetPanicHandler(func(msg string) { msgs = append(msgs, msg) })
+	defer SetPanicHandler(nil)
+	if None[int]().Unwrap("") != nil || None[int]().Expect("expected") != nil || None[int]().MustPtr() != nil {
+		t.Error("failed methods did not return nil")
+	}
+	if len(msgs) != 3 || msgs[1] != "expected" {
+		t.Errorf("handler calls: %q", msgs)
+	}
+}
+
+func TestUnwrapChecked(t *testing.T) {
+	x := 1
+	if v, err := Some(&x).UnwrapChecked(); *v != 1 || err != nil {
+		t.Error("UnwrapChecked failed on Some")
+	}
+	if v, err := None[int]().UnwrapChecked(); v != nil || !errors.Is(err, ErrNone) {
+		t.Error("UnwrapChecked failed on None")
+	}
+}
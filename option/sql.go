@@ -0,0 +1,138 @@
+package option
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"time"
+)
+
+// ToSQLNull converts the option to a `sql.Null[T]`, [`None`] being invalid.
+func ToSQLNull[T any](o *Option[T]) sql.Null[T] {
+	consumed(o)
+	if o.value == nil {
+		return sql.Null[T]{}
+	}
+	return sql.Null[T]{V: *o.value, Valid: true}
+}
+
+// FromSQLNull converts a `sql.Null[T]` to an option, [`None`] if it is invalid.
+func FromSQLNull[T any](n sql.Null[T]) *Option[T] {
+	return fromNull(n.V, n.Valid)
+}
+
+func fromNull[T any](v T, valid bool) *Option[T] {
+	if !valid {
+		return None[T]()
+	}
+	return Some(&v)
+}
+
+// ToNullString converts the option to a `sql.NullString`.
+func ToNullString(o *Option[string]) sql.NullString {
+	n := ToSQLNull(o)
+	return sql.NullString{String: n.V, Valid: n.Valid}
+}
+
+// FromNullString converts a `sql.NullString` to an option.
+func FromNullString(n sql.NullString) *Option[string] {
+	return fromNull(n.String, n.Valid)
+}
+
+// ToNullInt64 converts the option to a `sql.NullInt64`.
+func ToNullInt64(o *Option[int64]) sql.NullInt64 {
+	n := ToSQLNull(o)
+	return sql.NullInt64{Int64: n.V, Valid: n.Valid}
+}
+
+// FromNullInt64 converts a `sql.NullInt64` to an option.
+func FromNullInt64(n sql.NullInt64) *Option[int64] {
+	return fromNull(n.Int64, n.Valid)
+}
+
+// ToNullInt32 converts the option to a `sql.NullInt32`.
+func ToNullInt32(o *Option[int32]) sql.NullInt32 {
+	n := ToSQLNull(o)
+	return sql.NullInt32{Int32: n.V, Valid: n.Valid}
+}
+
+// FromNullInt32 converts a `sql.NullInt32` to an option.
+func FromNullInt32(n sql.NullInt32) *Option[int32] {
+	return fromNull(n.Int32, n.Valid)
+}
+
+// ToNullInt16 converts the option to a `sql.NullInt16`.
+func ToNullInt16(o *Option[int16]) sql.NullInt16 {
+	n := ToSQLNull(o)
+	return sql.NullInt16{Int16: n.V, Valid: n.Valid}
+}
+
+// FromNullInt16 converts a `sql.NullInt16` to an option.
+func FromNullInt16(n sql.NullInt16) *Option[int16] {
+	return fromNull(n.Int16, n.Valid)
+}
+
+// ToNullByte converts the option to a `sql.NullByte`.
+func ToNullByte(o *Option[byte]) sql.NullByte {
+	n := ToSQLNull(o)
+	return sql.NullByte{Byte: n.V, Valid: n.Valid}
+}
+
+// FromNullByte converts a `sql.NullByte` to an option.
+func FromNullByte(n sql.NullByte) *Option[byte] {
+	return fromNull(n.Byte, n.Valid)
+}
+
+// ToNullFloat64 converts the option to a `sql.NullFloat64`.
+func ToNullFloat64(o *Option[float64]) sql.NullFloat64 {
+	n := ToSQLNull(o)
+	return sql.NullFloat64{Float64: n.V, Valid: n.Valid}
+}
+
+// FromNullFloat64 converts a `sql.NullFloat64` to an option.
+func FromNullFloat64(n sql.NullFloat64) *Option[float64] {
+	return fromNull(n.Float64, n.Valid)
+}
+
+// ToNullBool converts the option to a `sql.NullBool`.
+func ToNullBool(o *Option[bool]) sql.NullBool {
+	n := ToSQLNull(o)
+	return sql.NullBool{Bool: n.V, Valid: n.Valid}
+}
+
+// FromNullBool converts a `sql.NullBool` to an option.
+func FromNullBool(n sql.NullBool) *Option[bool] {
+	return fromNull(n.Bool, n.Valid)
+}
+
+// ToNullTime converts the option to a `sql.NullTime`.
+func ToNullTime(o *Option[time.Time]) sql.NullTime {
+	n := ToSQLNull(o)
+	return sql.NullTime{Time: n.V, Valid: n.Valid}
+}
+
+// FromNullTime converts a `sql.NullTime` to an option.
+func FromNullTime(n sql.NullTime) *Option[time.Time] {
+	return fromNull(n.Time, n.Valid)
+}
+
+// Scan implements `sql.Scanner`, so options scan directly from the rows of database/sql and pgx:
+// NULL scans to [`None`], other values to [`Some`], converted like the values scanned into a `T`,
+// through the `sql.Scanner` of `*T` if any.
+func (o *Option[T]) Scan(src any) error {
+	var n sql.Null[T]
+	if err := n.Scan(src); err != nil {
+		return err
+	}
+	if !n.Valid {
+		o.value = nil
+		return nil
+	}
+	o.value = &n.V
+	return nil
+}
+
+// Value implements `driver.Valuer`, so options bind as query arguments of database/sql and pgx:
+// [`None`] binds as NULL, [`Some`] as its value, through the `driver.Valuer` of `T` if any.
+func (o Option[T]) Value() (driver.Value, error) {
+	return ToSQLNull(&o).Value()
+}
@@ -0,0 +1,69 @@
+package option
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+)
+
+func TestSQLNull(t *testing.T) {
+	x := 1
+	if n := ToSQLNull(Some(&x)); !n.Valid || n.V != 1 {
+		t.Error("ToSQLNull failed on Some")
+	}
+	if ToSQLNull(None[int]()).Valid {
+		t.Error("ToSQLNull failed on None")
+	}
+	if *FromSQLNull(sql.Null[int]{V: 2, Valid: true}).Unwrap("") != 2 || FromSQLNull(sql.Null[int]{V: 2}).IsSome() {
+		t.Error("FromSQLNull failed")
+	}
+}
+
+func TestNullLegacy(t *testing.T) {
+	s := "a"
+	if n := ToNullString(Some(&s)); n.String != "a" || !n.Valid || *FromNullString(n).Unwrap("") != "a" {
+		t.Error("NullString failed")
+	}
+	if FromNullInt64(ToNullInt64(None[int64]())).IsSome() {
+		t.Error("NullInt64 failed")
+	}
+	now := time.Now()
+	if !FromNullTime(ToNullTime(Some(&now))).Unwrap("").Equal(now) {
+		t.Error("NullTime failed")
+	}
+	b := true
+	if !*FromNullBool(ToNullBool(Some(&b))).Unwrap("") {
+		t.Error("NullBool failed")
+	}
+}
+
+func TestScanValue(t *testing.T) {
+	var o Option[int64]
+	if err := o.Scan(int64(3)); err != nil || *o.Unwrap("") != 3 {
+		t.Errorf("Scan failed: %v", err)
+	}
+	if err := o.Scan(nil); err != nil || o.IsSome() {
+		t.Error("Scan failed on NULL")
+	}
+	if err := o.Scan("x"); err == nil {
+		t.Error("Scan failed to reject a string")
+	}
+	var s Option[string]
+	if err := s.Scan([]byte("a")); err != nil || *s.Unwrap("") != "a" {
+		t.Error("Scan failed to convert bytes")
+	}
+	var n Option[sql.NullString]
+	if err := n.Scan("b"); err != nil || n.Unwrap("").String != "b" {
+		t.Error("Scan failed through sql.Scanner")
+	}
+
+	if v, err := s.Value(); err != nil || v != "a" {
+		t.Errorf("Value failed: %v, %v", v, err)
+	}
+	if v, err := None[string]().Value(); err != nil || v != nil {
+		t.Error("Value failed on None")
+	}
+	if v, err := n.Value(); err != nil || v != "b" {
+		t.Errorf("Value failed through driver.Valuer: %v", v)
+	}
+}
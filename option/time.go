@@ -0,0 +1,11 @@
+package option
+
+import "time"
+
+// FromZeroTime returns [`None`] for the zero time, otherwise [`Some`] of `t`.
+func FromZeroTime(t time.Time) *Option[time.Time] {
+	if t.IsZero() {
+		return None[time.Time]()
+	}
+	return Some(&t)
+}
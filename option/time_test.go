@@ -0,0 +1,12 @@
+package option
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFromZeroTime(t *testing.T) {
+	if FromZeroTime(time.Time{}).IsSome() || FromZeroTime(time.Now()).IsNone() {
+		t.Error("FromZeroTime failed")
+	}
+}
@@ -0,0 +1,70 @@
+package option
+
+import (
+	"sync/atomic"
+
+	"github.com/yuanzicheng/go-result-and-option/internal/construct"
+	"github.com/yuanzicheng/go-result-and-option/internal/peek"
+)
+
+// Tracker is notified of the creation and the consumption of options,
+// see package resultdebug.
+type Tracker interface {
+	// Created is called with every new option.
+	Created(o any)
+	// Consumed is called every time an option is checked or unwrapped.
+	Consumed(o any)
+}
+
+var tracker atomic.Pointer[Tracker]
+
+// SetTracker sets the tracker of options, replacing any previous one. A nil tracker removes it.
+func SetTracker(t Tracker) {
+	if t == nil {
+		tracker.Store(nil)
+		return
+	}
+	tracker.Store(&t)
+}
+
+func created[T any](o *Option[T]) *Option[T] {
+	if counting.Load() {
+		count(o.value != nil)
+	}
+	if t := tracker.Load(); t != nil {
+		(*t).Created(o)
+	}
+	return o
+}
+
+func consumed[T any](o *Option[T]) {
+	if t := tracker.Load(); t != nil {
+		(*t).Consumed(o)
+	}
+}
+
+func init() {
+	peek.Option = func(o any) any {
+		return o.(interface{ peek() any }).peek()
+	}
+	construct.Option = func(o, v any) {
+		o.(interface{ construct(v any) }).construct(v)
+	}
+}
+
+// construct sets the option allocated by another package to hold `v`, like New.
+func (o *Option[T]) construct(v any) {
+	o.value = v.(*T)
+	if o.value == nil {
+		// Report the caller of construct.Option, not this method nor the function literal.
+		fireNoneAt(Created, 4)
+	}
+	created(o)
+}
+
+func (o *Option[T]) peek() any {
+	if o == nil {
+		return (*T)(nil)
+	}
+	return o.value
+}
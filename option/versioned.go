@@ -0,0 +1,85 @@
+package option
+
+// VersionedOption is an `Option[T]` that remembers the values it held before its last `n` replacements.
+// It is not safe for concurrent use.
+type VersionedOption[T any] struct {
+	Option[T]
+	n       int
+	history []*T
+}
+
+// NewVersioned returns a [`None`] that keeps up to `n` previous values; `n` is at least 1.
+func NewVersioned[T any](n int) *VersionedOption[T] {
+	return &VersionedOption[T]{n: max(n, 1)}
+}
+
+func (o *VersionedOption[T]) record(old *T) {
+	if o.n == 0 {
+		o.n = 1
+	}
+	if len(o.history) == o.n {
+		copy(o.history, o.history[1:])
+		o.history = o.history[:o.n-1]
+	}
+	o.history = append(o.history, old)
+}
+
+// Set replaces the value, recording the old one.
+func (o *VersionedOption[T]) Set(v *T) {
+	o.Replace(v)
+}
+
+// Replace replaces the value, recording the old one, and returns the old value if present.
+func (o *VersionedOption[T]) Replace(v *T) *T {
+	old := o.Option.Replace(v)
+	o.record(old)
+	return old
+}
+
+// ReplaceIfChanged replaces the value by `v` only if it differs from the current one,
+// recording the old value, and reports whether it did.
+func (o *VersionedOption[T]) ReplaceIfChanged(v *T, eq func(a, b *T) bool) bool {
+	old := o.value
+	if !o.Option.ReplaceIfChanged(v, eq) {
+		return false
+	}
+	o.record(old)
+	return true
+}
+
+// Take takes the value out, recording it, and leaves a [`None`] in its place.
+func (o *VersionedOption[T]) Take() *T {
+	old := o.Option.Take()
+	o.record(old)
+	return old
+}
+
+// Current returns the current value as an option.
+func (o *VersionedOption[T]) Current() *Option[T] {
+	return New(o.value)
+}
+
+// Previous returns the value held before the last change,
+// or [`None`] if there was none or nothing has changed yet.
+func (o *VersionedOption[T]) Previous() *Option[T] {
+	if len(o.history) == 0 {
+		return None[T]()
+	}
+	return New(o.history[len(o.history)-1])
+}
+
+// History returns the recorded previous values, oldest first.
+// Entries that were [`None`] are kept as [`None`].
+func (o *VersionedOption[T]) History() []*Option[T] {
+	out := make([]*Option[T], len(o.history))
+	for i, v := range o.history {
+		out[i] = New(v)
+	}
+	return out
+}
+
+// Changed reports whether the current value differs from [`Previous`] according to `eq`.
+// It is `false` until the option has been changed at least once.
+func (o *VersionedOption[T]) Changed(eq func(a, b *T) bool) bool {
+	return len(o.history) > 0 && Changed(o.Current(), o.Previous(), eq)
+}
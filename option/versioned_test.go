@@ -0,0 +1,54 @@
+package option
+
+import "testing"
+
+func TestVersionedOption(t *testing.T) {
+	o := NewVersioned[int](2)
+	if !o.Previous().IsNone() || len(o.History()) != 0 {
+		t.Error("new option has history")
+	}
+
+	a, b, c := 1, 2, 3
+	o.Set(&a)
+	if !o.Previous().IsNone() || *o.Current().Unwrap("") != 1 {
+		t.Error("Set failed")
+	}
+	if old := o.Replace(&b); *old != 1 || *o.Previous().Unwrap("") != 1 {
+		t.Error("Replace failed")
+	}
+	o.Set(&c)
+	h := o.History()
+	if len(h) != 2 || *h[0].Unwrap("") != 1 || *h[1].Unwrap("") != 2 {
+		t.Error("History is not bounded to the last 2 values")
+	}
+	if *o.Take() != 3 || !o.IsNone() || *o.Previous().Unwrap("") != 3 {
+		t.Error("Take failed")
+	}
+
+	var z VersionedOption[int]
+	z.Set(&a)
+	z.Set(&b)
+	if len(z.History()) != 1 || *z.Previous().Unwrap("") != 1 {
+		t.Error("zero value does not keep one previous value")
+	}
+}
+
+func TestVersionedOptionChanged(t *testing.T) {
+	eq := func(a, b *string) bool { return *a == *b }
+	o := NewVersioned[string](1)
+	if o.Changed(eq) {
+		t.Error("new option reported a change")
+	}
+	x, y := "a", "a"
+	o.ReplaceIfChanged(&x, eq)
+	if !o.Changed(eq) {
+		t.Error("None to Some not reported")
+	}
+	if o.ReplaceIfChanged(&y, eq) || !o.Previous().IsNone() {
+		t.Error("unchanged value was recorded")
+	}
+	o.Set(&y)
+	if o.Changed(eq) {
+		t.Error("equal values reported a change")
+	}
+}
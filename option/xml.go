@@ -0,0 +1,67 @@
+package option
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+// MarshalXML encodes the contained value of a [`Some`] as the element, and nothing for a [`None`],
+// so `omitempty` isn't needed to leave out missing elements.
+func (o Option[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if o.value == nil {
+		return nil
+	}
+	return e.EncodeElement(o.value, start)
+}
+
+// UnmarshalXML decodes a present element into a [`Some`], even if it is empty.
+// Options of absent elements are left untouched, i.e. [`None`] for zero options.
+func (o *Option[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	v := new(T)
+	if err := d.DecodeElement(v, &start); err != nil {
+		return err
+	}
+	o.value = v
+	return nil
+}
+
+// MarshalXMLAttr encodes the contained value of a [`Some`] as the attribute, and no attribute for a [`None`].
+// The value is formatted as encoding/xml formats element text.
+func (o Option[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if o.value == nil {
+		return xml.Attr{}, nil
+	}
+	if m, ok := any(o.value).(xml.MarshalerAttr); ok {
+		return m.MarshalXMLAttr(name)
+	}
+	b, err := xml.Marshal(o.value)
+	if err != nil {
+		return xml.Attr{}, err
+	}
+	var text string
+	if err := xml.Unmarshal(b, &text); err != nil {
+		return xml.Attr{}, err
+	}
+	return xml.Attr{Name: name, Value: text}, nil
+}
+
+// UnmarshalXMLAttr decodes a present attribute into a [`Some`], even if it is empty.
+// The value is parsed as encoding/xml parses element text.
+func (o *Option[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	v := new(T)
+	if u, ok := any(v).(xml.UnmarshalerAttr); ok {
+		if err := u.UnmarshalXMLAttr(attr); err != nil {
+			return err
+		}
+	} else {
+		var b strings.Builder
+		b.WriteString("<v>")
+		xml.EscapeText(&b, []byte(attr.Value))
+		b.WriteString("</v>")
+		if err := xml.Unmarshal([]byte(b.String()), v); err != nil {
+			return err
+		}
+	}
+	o.value = v
+	return nil
+}
@@ -0,0 +1,33 @@
+package option
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+type xmlUser struct {
+	XMLName xml.Name       `xml:"user"`
+	ID      Option[int]    `xml:"id,attr"`
+	Role    Option[string] `xml:"role,attr"`
+	Name    Option[string] `xml:"name"`
+	Email   Option[string] `xml:"email"`
+}
+
+func TestXML(t *testing.T) {
+	id, name := 7, "ann & bob"
+	b, err := xml.Marshal(xmlUser{ID: *Some(&id), Name: *Some(&name)})
+	if err != nil || string(b) != `<user id="7"><name>ann &amp; bob</name></user>` {
+		t.Errorf("MarshalXML failed: %s %v", b, err)
+	}
+
+	var u xmlUser
+	if err := xml.Unmarshal([]byte(`<user id="7" role=""><email></email></user>`), &u); err != nil {
+		t.Fatal(err)
+	}
+	if *u.ID.Unwrap("") != 7 || *u.Role.Unwrap("") != "" || u.Name.IsSome() || *u.Email.Unwrap("") != "" {
+		t.Errorf("UnmarshalXML failed: %+v", u)
+	}
+	if xml.Unmarshal([]byte(`<user id="x"></user>`), &u) == nil {
+		t.Error("UnmarshalXMLAttr accepted an invalid value")
+	}
+}
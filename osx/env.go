@@ -0,0 +1,48 @@
+package osx
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// ErrEnvNotSet is returned by RequireEnv for unset environment variables.
+var ErrEnvNotSet = errors.New("environment variable not set")
+
+// Getenv returns the value of the environment variable, or [`None`] if it is unset.
+// A variable set to the empty string is a [`Some`].
+func Getenv(name string) *option.Option[string] {
+	v, ok := os.LookupEnv(name)
+	if !ok {
+		return option.None[string]()
+	}
+	return option.Some(&v)
+}
+
+// GetenvParsed parses the value of the environment variable with `parse`.
+// Returns [`Ok`] of [`None`] if the variable is unset, or an [`Err`] naming
+// the variable if it can't be parsed.
+func GetenvParsed[T any](name string, parse func(string) *result.Result[T]) *result.Result[option.Option[T]] {
+	v, ok := os.LookupEnv(name)
+	if !ok {
+		return result.Ok(option.None[T]())
+	}
+	r := parse(v)
+	if r.IsErr() {
+		return result.Err[option.Option[T]](fmt.Errorf("parsing %s: %w", name, r.UnwrapError()))
+	}
+	return result.Ok(option.New(r.Unwrap()))
+}
+
+// RequireEnv returns the value of the environment variable, or an [`Err`]
+// wrapping ErrEnvNotSet if it is unset.
+func RequireEnv(name string) *result.Result[string] {
+	v, ok := os.LookupEnv(name)
+	if !ok {
+		return result.Err[string](fmt.Errorf("%w: %s", ErrEnvNotSet, name))
+	}
+	return result.Ok(&v)
+}
@@ -0,0 +1,33 @@
+package osx
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+func atoi(s string) *result.Result[int] {
+	n, err := strconv.Atoi(s)
+	return result.New(&n, err)
+}
+
+func TestGetenv(t *testing.T) {
+	t.Setenv("OSX_TEST_EMPTY", "")
+	t.Setenv("OSX_TEST_PORT", "8080")
+	t.Setenv("OSX_TEST_BAD", "x")
+
+	if *Getenv("OSX_TEST_EMPTY").Unwrap("") != "" || Getenv("OSX_TEST_UNSET").IsSome() {
+		t.Error("Getenv failed")
+	}
+	if port := GetenvParsed("OSX_TEST_PORT", atoi).Unwrap(); *port.Unwrap("") != 8080 {
+		t.Error("GetenvParsed failed")
+	}
+	if !GetenvParsed("OSX_TEST_UNSET", atoi).Unwrap().IsNone() || GetenvParsed("OSX_TEST_BAD", atoi).IsOk() {
+		t.Error("GetenvParsed failed")
+	}
+	if *RequireEnv("OSX_TEST_PORT").Unwrap() != "8080" || !errors.Is(RequireEnv("OSX_TEST_UNSET").UnwrapError(), ErrEnvNotSet) {
+		t.Error("RequireEnv failed")
+	}
+}
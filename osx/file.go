@@ -0,0 +1,67 @@
+package osx
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// classify tags not-exist errors with `errkind.NotFound`.
+func classify(err error) error {
+	if errors.Is(err, fs.ErrNotExist) {
+		return errkind.Wrap(errkind.NotFound, err)
+	}
+	return err
+}
+
+func done(err error) *result.Result[struct{}] {
+	if err != nil {
+		return result.Err[struct{}](classify(err))
+	}
+	return result.Ok(&struct{}{})
+}
+
+// Open opens the named file for reading, as `os.Open` does.
+func Open(name string) *result.Result[os.File] {
+	f, err := os.Open(name)
+	if err != nil {
+		return result.Err[os.File](classify(err))
+	}
+	return result.Ok(f)
+}
+
+// ReadFile reads the named file, as `os.ReadFile` does.
+func ReadFile(name string) *result.Result[[]byte] {
+	data, err := os.ReadFile(name)
+	if err != nil {
+		return result.Err[[]byte](classify(err))
+	}
+	return result.Ok(&data)
+}
+
+// WriteFile writes data to the named file, as `os.WriteFile` does.
+func WriteFile(name string, data []byte, perm os.FileMode) *result.Result[struct{}] {
+	return done(os.WriteFile(name, data, perm))
+}
+
+// Stat returns the file info of the named file, as `os.Stat` does.
+func Stat(name string) *result.Result[fs.FileInfo] {
+	fi, err := os.Stat(name)
+	if err != nil {
+		return result.Err[fs.FileInfo](classify(err))
+	}
+	return result.Ok(&fi)
+}
+
+// MkdirAll creates a directory along with its parents, as `os.MkdirAll` does.
+func MkdirAll(path string, perm os.FileMode) *result.Result[struct{}] {
+	return done(os.MkdirAll(path, perm))
+}
+
+// Remove removes the named file or empty directory, as `os.Remove` does.
+func Remove(name string) *result.Result[struct{}] {
+	return done(os.Remove(name))
+}
@@ -0,0 +1,34 @@
+package osx
+
+import (
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+)
+
+func TestFile(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "a", "b")
+	name := filepath.Join(dir, "f.txt")
+	if MkdirAll(dir, 0o755).IsErr() || WriteFile(name, []byte("hi"), 0o644).IsErr() {
+		t.Fatal("MkdirAll/WriteFile failed")
+	}
+	if string(*ReadFile(name).Unwrap()) != "hi" || (*Stat(name).Unwrap()).Size() != 2 {
+		t.Error("ReadFile/Stat failed")
+	}
+	f := Open(name).Unwrap()
+	f.Close()
+
+	if Remove(name).IsErr() {
+		t.Error("Remove failed")
+	}
+	err := ReadFile(name).UnwrapError()
+	if !errors.Is(err, errkind.NotFound) || !errors.Is(err, fs.ErrNotExist) {
+		t.Error("ReadFile failed to classify a missing file")
+	}
+	if !errors.Is(Open(name).UnwrapError(), errkind.NotFound) {
+		t.Error("Open failed to classify a missing file")
+	}
+}
@@ -0,0 +1,50 @@
+package otelx
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/internal/peek"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+const scope = "github.com/yuanzicheng/go-result-and-option/otelx"
+
+// KindKey is the attribute key of the error kind recorded with error events.
+const KindKey = attribute.Key("error.kind")
+
+// Record records the error of an [`Err`] on the span of the context: it adds an error
+// event with the kind of the error and sets the span status to Error. The status of
+// an [`Ok`] is left unset. It returns the result unchanged.
+func Record[T any](ctx context.Context, r *result.Result[T]) *result.Result[T] {
+	_, err := peek.Result(r)
+	if err == nil {
+		return r
+	}
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		return r
+	}
+	var opts []trace.EventOption
+	if kind := errkind.KindOf(err); kind != "" {
+		opts = append(opts, trace.WithAttributes(KindKey.String(string(kind))))
+	}
+	span.RecordError(err, opts...)
+	span.SetStatus(codes.Error, err.Error())
+	return r
+}
+
+// Traced returns a function calling `f` in a new span named `name`, started with
+// the global tracer provider, and recording its result with Record.
+func Traced[T any](name string, f func(context.Context) *result.Result[T], opts ...trace.SpanStartOption) func(context.Context) *result.Result[T] {
+	return func(ctx context.Context) *result.Result[T] {
+		ctx, span := otel.Tracer(scope).Start(ctx, name, opts...)
+		defer span.End()
+		return Record(ctx, f(ctx))
+	}
+}
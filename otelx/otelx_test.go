@@ -0,0 +1,50 @@
+package otelx
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+func TestTraced(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+
+	f := Traced("get_user", func(ctx context.Context) *result.Result[int] {
+		return result.Err[int](errkind.Wrap(errkind.NotFound, errors.New("no such user")))
+	})
+	if f(context.Background()).IsOk() {
+		t.Fatal("Traced changed the result")
+	}
+	x := 1
+	Traced("ok", func(ctx context.Context) *result.Result[int] { return result.Ok(&x) })(context.Background())
+
+	spans := exporter.GetSpans()
+	if len(spans) != 2 || spans[0].Name != "get_user" || spans[0].Status.Code != codes.Error || spans[0].Status.Description != "no such user" {
+		t.Fatalf("Traced failed: %+v", spans)
+	}
+	events := spans[0].Events
+	if len(events) != 1 || events[0].Name != "exception" {
+		t.Fatalf("Record failed: %+v", events)
+	}
+	found := false
+	for _, a := range events[0].Attributes {
+		if a.Key == KindKey && a.Value.AsString() == "not_found" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Record failed: %+v", events[0].Attributes)
+	}
+	if spans[1].Status.Code != codes.Unset || len(spans[1].Events) != 0 {
+		t.Errorf("Record failed on Ok: %+v", spans[1])
+	}
+}
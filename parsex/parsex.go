@@ -0,0 +1,36 @@
+package parsex
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// ErrNoParser is returned by Parse for types without a registered parser.
+var ErrNoParser = errors.New("parsex: no parser registered")
+
+var parsers sync.Map // map[reflect.Type]func(string) (T, error)
+
+// Register sets the parser of the type `T`, replacing any previous one.
+func Register[T any](parser func(string) (T, error)) {
+	parsers.Store(reflect.TypeFor[T](), parser)
+}
+
+// Parse parses `s` into a `T` with its registered parser.
+// Parsing failures are tagged with `errkind.Invalid`.
+func Parse[T any](s string) *result.Result[T] {
+	t := reflect.TypeFor[T]()
+	p, ok := parsers.Load(t)
+	if !ok {
+		return result.Err[T](fmt.Errorf("%w for %v", ErrNoParser, t))
+	}
+	v, err := p.(func(string) (T, error))(s)
+	if err != nil {
+		return result.Err[T](errkind.Wrap(errkind.Invalid, err))
+	}
+	return result.Ok(&v)
+}
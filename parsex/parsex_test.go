@@ -0,0 +1,37 @@
+package parsex
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+)
+
+type color int
+
+func parseColor(s string) (color, error) {
+	switch s {
+	case "red":
+		return 1, nil
+	case "green":
+		return 2, nil
+	}
+	return 0, fmt.Errorf("unknown color %q", s)
+}
+
+func TestParse(t *testing.T) {
+	Register(parseColor)
+	Register(time.ParseDuration)
+
+	if *Parse[color]("green").Unwrap() != 2 || !errors.Is(Parse[color]("blue").UnwrapError(), errkind.Invalid) {
+		t.Error("Parse failed")
+	}
+	if *Parse[time.Duration]("2s").Unwrap() != 2*time.Second {
+		t.Error("Parse failed")
+	}
+	if !errors.Is(Parse[uint8]("1").UnwrapError(), ErrNoParser) {
+		t.Error("Parse failed without parser")
+	}
+}
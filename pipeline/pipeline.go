@@ -0,0 +1,82 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// From emits every value as an [`Ok`] result, stopping early if the context is done.
+func From[T any](ctx context.Context, vs ...*T) <-chan *result.Result[T] {
+	out := make(chan *result.Result[T])
+	go func() {
+		defer close(out)
+		for _, v := range vs {
+			select {
+			case out <- result.Ok(v):
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// Stage runs `f` on every [`Ok`] value received from `in` with `workers` goroutines,
+// forwarding [`Err`] values untouched. The output channel is buffered by the
+// number of workers, so a slow consumer slows down the whole pipeline.
+// Results are not kept in order when `workers` is greater than one.
+// Once the context is done, the workers stop and the output channel is closed.
+func Stage[T any, U any](ctx context.Context, in <-chan *result.Result[T], workers int, f func(context.Context, *T) *result.Result[U]) <-chan *result.Result[U] {
+	if workers < 1 {
+		workers = 1
+	}
+	out := make(chan *result.Result[U], workers)
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for r := range in {
+				select {
+				case out <- result.AndThenCtx(ctx, r, f):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// Summary counts the outcomes of a pipeline.
+type Summary struct {
+	Ok   int
+	Errs []error
+}
+
+// Err returns all the errors joined with `errors.Join`, nil if there were none.
+func (s *Summary) Err() error {
+	return errors.Join(s.Errs...)
+}
+
+// Collect drains `in`, returning the [`Ok`] values and a summary of the outcomes.
+func Collect[T any](in <-chan *result.Result[T]) ([]*T, *Summary) {
+	var vs []*T
+	s := &Summary{}
+	for r := range in {
+		if r.IsErr() {
+			s.Errs = append(s.Errs, r.UnwrapError())
+			continue
+		}
+		s.Ok++
+		vs = append(vs, r.Unwrap())
+	}
+	return vs, s
+}
@@ -0,0 +1,39 @@
+package pipeline
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+func TestPipeline(t *testing.T) {
+	ctx := context.Background()
+	in := []string{"1", "2", "x", "4"}
+	vs := make([]*string, len(in))
+	for i := range in {
+		vs[i] = &in[i]
+	}
+
+	parsed := Stage(ctx, From(ctx, vs...), 2, func(ctx context.Context, s *string) *result.Result[int] {
+		n, err := strconv.Atoi(*s)
+		return result.New(&n, err)
+	})
+	doubled := Stage(ctx, parsed, 2, func(ctx context.Context, n *int) *result.Result[int] {
+		x := *n * 2
+		return result.Ok(&x)
+	})
+
+	out, summary := Collect(doubled)
+	if len(out) != 3 || summary.Ok != 3 || len(summary.Errs) != 1 || summary.Err() == nil {
+		t.Error("Collect failed")
+	}
+	sum := 0
+	for _, v := range out {
+		sum += *v
+	}
+	if sum != 14 {
+		t.Error("Stage failed")
+	}
+}
@@ -0,0 +1,45 @@
+package ratelimitx
+
+import (
+	"context"
+	"errors"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// ErrRateLimited is returned by functions wrapped with TryWrap when no token is available.
+var ErrRateLimited = errors.New("rate limited")
+
+// Waiter blocks until a token is available or the context is done,
+// e.g. `*rate.Limiter` from golang.org/x/time/rate.
+type Waiter interface {
+	Wait(ctx context.Context) error
+}
+
+// Allower reports whether a token is available right now without blocking,
+// e.g. `*rate.Limiter` from golang.org/x/time/rate.
+type Allower interface {
+	Allow() bool
+}
+
+// Wrap returns a function that waits for a token from `limiter` before calling `f`.
+// Returns an [`Err`] with the error of `Wait` if no token could be obtained.
+func Wrap[T any](limiter Waiter, f func(context.Context) *result.Result[T]) func(context.Context) *result.Result[T] {
+	return func(ctx context.Context) *result.Result[T] {
+		if err := limiter.Wait(ctx); err != nil {
+			return result.Err[T](err)
+		}
+		return f(ctx)
+	}
+}
+
+// TryWrap returns a function that calls `f` only if `limiter` has a token available,
+// otherwise returns an [`Err`] of `ErrRateLimited` without blocking.
+func TryWrap[T any](limiter Allower, f func(context.Context) *result.Result[T]) func(context.Context) *result.Result[T] {
+	return func(ctx context.Context) *result.Result[T] {
+		if !limiter.Allow() {
+			return result.Err[T](ErrRateLimited)
+		}
+		return f(ctx)
+	}
+}
@@ -0,0 +1,48 @@
+package ratelimitx
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+type tokens int
+
+func (n *tokens) Wait(ctx context.Context) error {
+	if *n == 0 {
+		return context.Canceled
+	}
+	*n--
+	return nil
+}
+
+func (n *tokens) Allow() bool {
+	return n.Wait(context.Background()) == nil
+}
+
+func TestWrap(t *testing.T) {
+	x := 1
+	f := func(ctx context.Context) *result.Result[int] {
+		return result.Ok(&x)
+	}
+
+	n := tokens(1)
+	g := Wrap(&n, f)
+	if !g(context.Background()).IsOk() {
+		t.Error("Wrap failed")
+	}
+	if !errors.Is(g(context.Background()).UnwrapError(), context.Canceled) {
+		t.Error("Wrap failed")
+	}
+
+	n = tokens(1)
+	h := TryWrap(&n, f)
+	if !h(context.Background()).IsOk() {
+		t.Error("TryWrap failed")
+	}
+	if !errors.Is(h(context.Background()).UnwrapError(), ErrRateLimited) {
+		t.Error("TryWrap failed")
+	}
+}
@@ -0,0 +1,45 @@
+package regexpx
+
+import (
+	"regexp"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// Compile parses a regular expression, as `regexp.Compile` does.
+func Compile(expr string) *result.Result[regexp.Regexp] {
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return result.Err[regexp.Regexp](err)
+	}
+	return result.Ok(re)
+}
+
+// Find returns the leftmost match of `re` in `s`, or [`None`] if there is no match.
+// An empty match is a [`Some`] of the empty string.
+func Find(re *regexp.Regexp, s string) *option.Option[string] {
+	loc := re.FindStringIndex(s)
+	if loc == nil {
+		return option.None[string]()
+	}
+	m := s[loc[0]:loc[1]]
+	return option.Some(&m)
+}
+
+// FindSubmatchMap returns the named groups of the leftmost match of `re` in `s`,
+// or [`None`] if there is no match. Groups that didn't participate in the match are omitted.
+func FindSubmatchMap(re *regexp.Regexp, s string) *option.Option[map[string]string] {
+	idx := re.FindStringSubmatchIndex(s)
+	if idx == nil {
+		return option.None[map[string]string]()
+	}
+	m := make(map[string]string)
+	for i, name := range re.SubexpNames() {
+		if name == "" || idx[2*i] < 0 {
+			continue
+		}
+		m[name] = s[idx[2*i]:idx[2*i+1]]
+	}
+	return option.Some(&m)
+}
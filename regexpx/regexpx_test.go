@@ -0,0 +1,20 @@
+package regexpx
+
+import "testing"
+
+func TestRegexp(t *testing.T) {
+	if Compile("(").IsOk() {
+		t.Error("Compile failed")
+	}
+	re := Compile(`(?P<key>\w+)=(?P<value>\w*)`).Unwrap()
+	if *Find(re, "a b=c").Unwrap("") != "b=c" || Find(re, "none").IsSome() {
+		t.Error("Find failed")
+	}
+	m := *FindSubmatchMap(re, "k=").Unwrap("")
+	if v, ok := m["value"]; m["key"] != "k" || !ok || v != "" {
+		t.Error("FindSubmatchMap failed")
+	}
+	if FindSubmatchMap(re, "none").IsSome() {
+		t.Error("FindSubmatchMap failed")
+	}
+}
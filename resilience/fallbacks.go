@@ -0,0 +1,33 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// Fallbacks calls each function in order and returns the first [`Ok`] result.
+// If every function fails, or the context is done before one succeeds,
+// returns an [`Err`] joining all the errors.
+func Fallbacks[T any](ctx context.Context, fns ...func(context.Context) *result.Result[T]) *result.Result[T] {
+	var errs []error
+	for _, f := range fns {
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, err)
+			break
+		}
+		r := f(ctx)
+		if r.IsOk() {
+			return r
+		}
+		errs = append(errs, r.UnwrapError())
+	}
+	if len(errs) == 0 {
+		errs = append(errs, ErrNoFunctions)
+	}
+	return result.Err[T](errors.Join(errs...))
+}
+
+// ErrNoFunctions is returned by combinators called without any function to run.
+var ErrNoFunctions = errors.New("resilience: no functions")
@@ -0,0 +1,34 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+func TestFallbacks(t *testing.T) {
+	errA, errB := errors.New("a"), errors.New("b")
+	fail := func(err error) func(context.Context) *result.Result[int] {
+		return func(context.Context) *result.Result[int] {
+			return result.Err[int](err)
+		}
+	}
+	x := 1
+	ok := func(context.Context) *result.Result[int] {
+		return result.Ok(&x)
+	}
+
+	ctx := context.Background()
+	if *Fallbacks(ctx, fail(errA), ok).Unwrap() != 1 {
+		t.Error("Fallbacks failed")
+	}
+	err := Fallbacks(ctx, fail(errA), fail(errB)).UnwrapError()
+	if !errors.Is(err, errA) || !errors.Is(err, errB) {
+		t.Error("Fallbacks failed to join errors")
+	}
+	if !errors.Is(Fallbacks[int](ctx).UnwrapError(), ErrNoFunctions) {
+		t.Error("Fallbacks failed without functions")
+	}
+}
@@ -0,0 +1,107 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+var hedgeHook atomic.Pointer[func(int, error)]
+
+// SetHedgeHook installs `f` to be called with the index and the error of every
+// function that failed or lost the race in Hedge. Passing nil removes the hook.
+func SetHedgeHook(f func(i int, err error)) {
+	if f == nil {
+		hedgeHook.Store(nil)
+		return
+	}
+	hedgeHook.Store(&f)
+}
+
+func reportHedge(i int, err error) {
+	if f := hedgeHook.Load(); f != nil {
+		(*f)(i, err)
+	}
+}
+
+type outcome[T any] struct {
+	i int
+	r *result.Result[T]
+}
+
+// Hedge calls the first function, then starts the next one each time `delay`
+// elapses or the previous one fails, and returns the first [`Ok`] result,
+// canceling the functions still running. If every function fails, or the context
+// is done first, returns an [`Err`] joining all the errors.
+// Errors of the losing functions are reported to the hook set by SetHedgeHook.
+func Hedge[T any](ctx context.Context, delay time.Duration, fns ...func(context.Context) *result.Result[T]) *result.Result[T] {
+	if len(fns) == 0 {
+		return result.Err[T](ErrNoFunctions)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	ch := make(chan outcome[T], len(fns))
+	launched, received := 0, 0
+	var timer *time.Timer
+	launch := func() {
+		i := launched
+		launched++
+		go func() {
+			ch <- outcome[T]{i, result.Catch(func() *result.Result[T] {
+				return fns[i](ctx)
+			})}
+		}()
+		if timer != nil {
+			timer.Stop()
+		}
+		timer = time.NewTimer(delay)
+	}
+	// drain reports the errors of the functions still running once they return.
+	drain := func(pending int) {
+		go func() {
+			for range pending {
+				if o := <-ch; o.r.IsErr() {
+					reportHedge(o.i, o.r.UnwrapError())
+				}
+			}
+		}()
+	}
+
+	launch()
+	defer func() {
+		timer.Stop()
+	}()
+	var errs []error
+	for received < len(fns) {
+		var next <-chan time.Time
+		if launched < len(fns) {
+			next = timer.C
+		}
+		select {
+		case o := <-ch:
+			received++
+			if o.r.IsOk() {
+				cancel()
+				drain(launched - received)
+				return o.r
+			}
+			errs = append(errs, o.r.UnwrapError())
+			reportHedge(o.i, o.r.UnwrapError())
+			if launched < len(fns) {
+				launch()
+			}
+		case <-next:
+			launch()
+		case <-ctx.Done():
+			errs = append(errs, ctx.Err())
+			cancel()
+			drain(launched - received)
+			return result.Err[T](errors.Join(errs...))
+		}
+	}
+	cancel()
+	return result.Err[T](errors.Join(errs...))
+}
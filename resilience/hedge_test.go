@@ -0,0 +1,50 @@
+package resilience
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+func TestHedge(t *testing.T) {
+	var losses atomic.Int32
+	SetHedgeHook(func(int, error) {
+		losses.Add(1)
+	})
+	defer SetHedgeHook(nil)
+
+	x, y := 1, 2
+	slow := func(ctx context.Context) *result.Result[int] {
+		<-ctx.Done()
+		return result.Err[int](ctx.Err())
+	}
+	fast := func(context.Context) *result.Result[int] {
+		return result.Ok(&y)
+	}
+	if *Hedge(context.Background(), time.Millisecond, slow, fast).Unwrap() != 2 {
+		t.Error("Hedge failed")
+	}
+
+	first := func(context.Context) *result.Result[int] {
+		return result.Ok(&x)
+	}
+	if *Hedge(context.Background(), time.Hour, first, fast).Unwrap() != 1 {
+		t.Error("Hedge failed")
+	}
+
+	errA := errors.New("a")
+	fail := func(context.Context) *result.Result[int] {
+		return result.Err[int](errA)
+	}
+	if !errors.Is(Hedge(context.Background(), time.Hour, fail, fail).UnwrapError(), errA) {
+		t.Error("Hedge failed to join errors")
+	}
+
+	for losses.Load() < 3 {
+		time.Sleep(time.Millisecond)
+	}
+}
@@ -0,0 +1,149 @@
+package result
+
+import (
+	"reflect"
+
+	"github.com/yuanzicheng/go-result-and-option/internal/construct"
+	"github.com/yuanzicheng/go-result-and-option/option"
+)
+
+// arenaChunk is the number of values allocated at once for each type.
+const arenaChunk = 64
+
+// Arena allocates results and options in contiguous chunks that are all given back together by `Reset`,
+// replacing one heap allocation per value with one per chunk.
+// It is meant to live for a single request and is not safe for concurrent use.
+type Arena struct {
+	slabs map[reflect.Type]resetter
+}
+
+type resetter interface {
+	reset()
+}
+
+type slab[E any] struct {
+	chunks [][]E
+	chunk  int // index of the chunk values are allocated from
+	used   int // number of values allocated from it
+}
+
+// alloc returns `n` contiguous values, moving to the next chunk if they don't fit in the current one.
+// Chunks hold arenaChunk values, or `n` if it is larger.
+func (s *slab[E]) alloc(n int) []E {
+	for s.chunk < len(s.chunks) && len(s.chunks[s.chunk])-s.used < n {
+		s.chunk, s.used = s.chunk+1, 0
+	}
+	if s.chunk == len(s.chunks) {
+		s.chunks = append(s.chunks, make([]E, max(n, arenaChunk)))
+	}
+	vs := s.chunks[s.chunk][s.used : s.used+n : s.used+n]
+	s.used += n
+	return vs
+}
+
+func (s *slab[E]) reset() {
+	for _, c := range s.chunks {
+		clear(c)
+	}
+	s.chunk, s.used = 0, 0
+}
+
+// NewArena returns an empty arena.
+func NewArena() *Arena {
+	return &Arena{slabs: make(map[reflect.Type]resetter)}
+}
+
+func alloc[E any](a *Arena) *E {
+	return &allocSlice[E](a, 1)[0]
+}
+
+// allocSlice returns a slice of `n` zero values allocated contiguously from the arena.
+// Its capacity is `n`, so appending to it moves it to the heap.
+func allocSlice[E any](a *Arena, n int) []E {
+	t := reflect.TypeFor[E]()
+	s, ok := a.slabs[t].(*slab[E])
+	if !ok {
+		s = &slab[E]{}
+		a.slabs[t] = s
+	}
+	return s.alloc(n)
+}
+
+// Reset gives back every value allocated from the arena at once, keeping the chunks for reuse.
+// Nothing allocated from the arena may be used after calling Reset.
+func (a *Arena) Reset() {
+	for _, s := range a.slabs {
+		s.reset()
+	}
+}
+
+// ArenaOk is like [`Ok`], but allocates the result from the arena.
+func ArenaOk[T any](a *Arena, v *T) *Result[T] {
+	r := alloc[Result[T]](a)
+	r.value = v
+	return created(r)
+}
+
+// ArenaErr is like [`Err`], but allocates the result from the arena.
+func ArenaErr[T any](a *Arena, err error) *Result[T] {
+	if err != nil {
+		fireErr(err, Created)
+	}
+	r := alloc[Result[T]](a)
+	r.err = err
+	return created(r)
+}
+
+// ArenaValue copies `v` into the arena and returns a pointer to the copy, e.g. for use with [`ArenaOk`].
+func ArenaValue[T any](a *Arena, v T) *T {
+	p := alloc[T](a)
+	*p = v
+	return p
+}
+
+// ArenaSome is like `option.Some`, but allocates the option from the arena.
+func ArenaSome[T any](a *Arena, v *T) *option.Option[T] {
+	o := alloc[option.Option[T]](a)
+	construct.Option(o, v)
+	return o
+}
+
+// ArenaNone is like `option.None`, but allocates the option from the arena.
+func ArenaNone[T any](a *Arena) *option.Option[T] {
+	o := alloc[option.Option[T]](a)
+	construct.Option(o, (*T)(nil))
+	return o
+}
+
+// ArenaCollect collects the values of the results into an [`Ok`] slice allocated from the arena,
+// returning the first [`Err`] instead if there is one. Nil [`Ok`] values are collected as zero values.
+func ArenaCollect[T any](a *Arena, rs []*Result[T]) *Result[[]T] {
+	out := allocSlice[T](a, len(rs))
+	for i, r := range rs {
+		consumed(r)
+		if r.err != nil {
+			return ArenaErr[[]T](a, r.err)
+		}
+		if r.value != nil {
+			out[i] = *r.value
+		}
+	}
+	return ArenaOk(a, ArenaValue(a, out))
+}
+
+// ArenaTraverse applies `f` to every element, collecting the results like [`ArenaCollect`]
+// and stopping at the first [`Err`].
+func ArenaTraverse[T any, U any](a *Arena, xs []T, f func(*T) *Result[U]) *Result[[]U] {
+	out := allocSlice[U](a, len(xs))
+	for i := range xs {
+		r := f(&xs[i])
+		consumed(r)
+		if r.err != nil {
+			return ArenaErr[[]U](a, r.err)
+		}
+		if r.value != nil {
+			out[i] = *r.value
+		}
+	}
+	return ArenaOk(a, ArenaValue(a, out))
+}
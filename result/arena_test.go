@@ -0,0 +1,98 @@
+package result
+
+import (
+	"errors"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+)
+
+func TestArena(t *testing.T) {
+	a := NewArena()
+	var rs []*Result[int]
+	for i := range 2*arenaChunk + 1 {
+		rs = append(rs, ArenaOk(a, ArenaValue(a, i)))
+	}
+	for i, r := range rs {
+		if *r.Unwrap() != i {
+			t.Fatalf("result %d holds %d", i, *r.Unwrap())
+		}
+	}
+	if chunk := a.slabs[reflect.TypeFor[Result[int]]()].(*slab[Result[int]]).chunks[0]; rs[1] != &chunk[1] {
+		t.Error("results are not allocated contiguously")
+	}
+
+	boom := errors.New("boom")
+	if !errors.Is(ArenaErr[int](a, boom).UnwrapError(), boom) {
+		t.Error("ArenaErr failed")
+	}
+	x := 1
+	if *ArenaSome(a, &x).Unwrap("") != 1 || !ArenaNone[int](a).IsNone() {
+		t.Error("ArenaSome/ArenaNone failed")
+	}
+
+	a.Reset()
+	if r := ArenaOk(a, &x); r != rs[0] {
+		t.Error("Reset did not reuse the chunks")
+	}
+}
+
+func TestArenaCollect(t *testing.T) {
+	a := NewArena()
+	one, two := 1, 2
+	c := ArenaCollect(a, []*Result[int]{ArenaOk(a, &one), ArenaOk[int](a, nil), ArenaOk(a, &two)})
+	if v := *c.Unwrap(); len(v) != 3 || v[0] != 1 || v[1] != 0 || v[2] != 2 {
+		t.Errorf("ArenaCollect = %v", v)
+	}
+	boom := errors.New("boom")
+	if !errors.Is(ArenaCollect(a, []*Result[int]{ArenaOk(a, &one), ArenaErr[int](a, boom)}).UnwrapError(), boom) {
+		t.Error("ArenaCollect did not return the Err")
+	}
+
+	tr := ArenaTraverse(a, []string{"1", "2"}, func(s *string) *Result[int] {
+		n, err := strconv.Atoi(*s)
+		if err != nil {
+			return ArenaErr[int](a, err)
+		}
+		return ArenaOk(a, ArenaValue(a, n))
+	})
+	if v := *tr.Unwrap(); len(v) != 2 || v[1] != 2 {
+		t.Errorf("ArenaTraverse = %v", v)
+	}
+}
+
+func TestArenaNoneHook(t *testing.T) {
+	var metas []option.Meta
+	option.SetNoneHook(func(m option.Meta) { metas = append(metas, m) })
+	defer option.SetNoneHook(nil)
+
+	a := NewArena()
+	x := 1
+	ArenaSome(a, &x)
+	ArenaNone[int](a)
+	if len(metas) != 1 || metas[0].Event != option.Created || filepath.Base(metas[0].File) != "arena_test.go" {
+		t.Errorf("ArenaNone called the None hook with %+v", metas)
+	}
+}
+
+func TestArenaCollectAllocs(t *testing.T) {
+	a := NewArena()
+	one := 1
+	rs := []*Result[int]{ArenaOk(a, &one), ArenaOk(a, &one)}
+	if n := testing.AllocsPerRun(100, func() { ArenaCollect(a, rs) }); n != 0 {
+		t.Errorf("ArenaCollect made %v heap allocations", n)
+	}
+}
+
+func BenchmarkArenaOk(b *testing.B) {
+	a := NewArena()
+	for i := 0; i < b.N; i++ {
+		if i%1024 == 0 {
+			a.Reset()
+		}
+		ArenaOk(a, &benchValue)
+	}
+}
@@ -0,0 +1,150 @@
+package result
+
+import (
+	"errors"
+	"testing"
+)
+
+var (
+	benchValue = 1
+	benchErr   = errors.New("boom")
+	benchOk    = Ok(&benchValue)
+	benchErrR  = Err[int](benchErr)
+)
+
+func inc(x *int) *int {
+	return x
+}
+
+func incResult(x *int) *Result[int] {
+	return benchOk
+}
+
+// TestAllocBudget enforces the allocations of the hot paths. The [`Ok`] path of Map
+// allocates the mapped result itself, which the pointer API can't avoid.
+func TestAllocBudget(t *testing.T) {
+	if testing.CoverMode() != "" {
+		t.Skip("allocations are not representative with coverage")
+	}
+	RegisterStatic(benchErr)
+	budgets := []struct {
+		name   string
+		budget float64
+		f      func()
+	}{
+		{"Map/Ok", 1, func() { Map(*benchOk, inc) }},
+		{"AndThen/Ok", 0, func() { AndThen(benchOk, incResult) }},
+		{"UnwrapOr/Ok", 0, func() { benchOk.UnwrapOr(&benchValue) }},
+		{"UnwrapOr/Err", 0, func() { benchErrR.UnwrapOr(&benchValue) }},
+		{"MapOr/Ok", 0, func() { MapOr(benchOk, &benchValue, inc) }},
+		{"And/Ok", 0, func() { And(benchOk, benchOk) }},
+		{"Or/Ok", 0, func() { benchOk.Or(benchErrR) }},
+		{"MapErr/Ok", 0, func() { MapErr(benchOk, func(err error) error { return err }) }},
+		{"IsOk", 0, func() { benchOk.IsOk() }},
+		{"ErrStatic", 0, func() { ErrStatic[int](benchErr) }},
+	}
+	for _, b := range budgets {
+		if allocs := testing.AllocsPerRun(100, b.f); allocs > b.budget {
+			t.Errorf("%s allocates %v times, budget is %v", b.name, allocs, b.budget)
+		}
+	}
+}
+
+func BenchmarkOk(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Ok(&benchValue)
+	}
+}
+
+func BenchmarkErr(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		Err[int](benchErr)
+	}
+}
+
+func BenchmarkMap(b *testing.B) {
+	b.Run("Ok", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			Map(*benchOk, inc)
+		}
+	})
+	b.Run("Err", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			Map(*benchErrR, inc)
+		}
+	})
+}
+
+func BenchmarkMapOr(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		MapOr(benchOk, &benchValue, inc)
+	}
+}
+
+func BenchmarkMapOrElse(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		MapOrElse(benchErrR, func(error) *int { return &benchValue }, inc)
+	}
+}
+
+func BenchmarkMapErr(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		MapErr(benchErrR, func(err error) error { return err })
+	}
+}
+
+func BenchmarkAnd(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		And(benchOk, benchOk)
+	}
+}
+
+func BenchmarkAndThen(b *testing.B) {
+	b.Run("Ok", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			AndThen(benchOk, incResult)
+		}
+	})
+	b.Run("Err", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			AndThen(benchErrR, incResult)
+		}
+	})
+}
+
+func BenchmarkOr(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchErrR.Or(benchOk)
+	}
+}
+
+func BenchmarkOrElse(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchErrR.OrElse(func(error) *Result[int] { return benchOk })
+	}
+}
+
+func BenchmarkUnwrapOr(b *testing.B) {
+	b.Run("Ok", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			benchOk.UnwrapOr(&benchValue)
+		}
+	})
+	b.Run("Err", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			benchErrR.UnwrapOr(&benchValue)
+		}
+	})
+}
+
+func BenchmarkUnwrapOrElse(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchErrR.UnwrapOrElse(func() *int { return &benchValue })
+	}
+}
+
+func BenchmarkInspect(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		benchOk.Inspect(func(*int) {})
+	}
+}
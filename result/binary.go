@@ -0,0 +1,63 @@
+package result
+
+import (
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// MarshalBinary encodes the result as a tag byte, 0 for [`Err`] and 1 for [`Ok`],
+// followed by the error message, or by the value encoded by its `encoding.BinaryMarshaler`
+// implementation or by `binary.Append` in big-endian order for fixed-size types.
+// A nil [`Ok`] value is encoded as the zero value.
+func (r *Result[T]) MarshalBinary() ([]byte, error) {
+	if r.err != nil {
+		return append([]byte{0}, r.err.Error()...), nil
+	}
+	v := r.value
+	if v == nil {
+		v = new(T)
+	}
+	if m, ok := any(v).(encoding.BinaryMarshaler); ok {
+		b, err := m.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte{1}, b...), nil
+	}
+	return binary.Append([]byte{1}, binary.BigEndian, v)
+}
+
+// UnmarshalBinary decodes a result encoded by MarshalBinary, the error of an [`Err`]
+// being decoded as an error with the same message.
+func (r *Result[T]) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return errors.New("result: empty binary data")
+	}
+	switch data[0] {
+	case 0:
+		r.value, r.err = nil, errors.New(string(data[1:]))
+		return nil
+	case 1:
+	default:
+		return fmt.Errorf("result: invalid tag byte %d", data[0])
+	}
+
+	v := new(T)
+	if u, ok := any(v).(encoding.BinaryUnmarshaler); ok {
+		if err := u.UnmarshalBinary(data[1:]); err != nil {
+			return err
+		}
+	} else {
+		n, err := binary.Decode(data[1:], binary.BigEndian, v)
+		if err != nil {
+			return err
+		}
+		if n != len(data)-1 {
+			return errors.New("result: trailing binary data after value")
+		}
+	}
+	r.value, r.err = v, nil
+	return nil
+}
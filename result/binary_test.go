@@ -0,0 +1,32 @@
+package result
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestBinary(t *testing.T) {
+	x := int16(258)
+	b, err := Ok(&x).MarshalBinary()
+	if err != nil || !bytes.Equal(b, []byte{1, 1, 2}) {
+		t.Errorf("MarshalBinary failed: %v %v", b, err)
+	}
+	var r Result[int16]
+	if err := r.UnmarshalBinary(b); err != nil || *r.Unwrap() != 258 {
+		t.Error("UnmarshalBinary failed")
+	}
+
+	b, _ = Err[int16](errors.New("boom")).MarshalBinary()
+	if err := r.UnmarshalBinary(b); err != nil || r.UnwrapError().Error() != "boom" {
+		t.Error("UnmarshalBinary failed on Err")
+	}
+	if b, _ := Ok[int16](nil).MarshalBinary(); !bytes.Equal(b, []byte{1, 0, 0}) {
+		t.Errorf("MarshalBinary failed on nil: %v", b)
+	}
+	for _, data := range [][]byte{nil, {2}, {1, 0}, {1, 0, 0, 0}} {
+		if r.UnmarshalBinary(data) == nil {
+			t.Errorf("UnmarshalBinary accepted %v", data)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package result
+
+import "context"
+
+// FromCtx returns an [`Err`] of `ctx.Err()` if the context is already done, otherwise an [`Ok`].
+func FromCtx(ctx context.Context) *Result[struct{}] {
+	if err := ctx.Err(); err != nil {
+		return Err[struct{}](err)
+	}
+	return Ok(&struct{}{})
+}
+
+// AndThenCtx calls `op` if the result is [`Ok`] and the context is not done,
+// otherwise returns the [`Err`] value of `in` or the context error.
+func AndThenCtx[T any, U any](ctx context.Context, in *Result[T], op func(context.Context, *T) *Result[U]) *Result[U] {
+	if in.IsErr() {
+		return step(in.trace, in.err, created(&Result[U]{err: in.err}), "AndThenCtx")
+	}
+	if err := ctx.Err(); err != nil {
+		return step(in.trace, nil, Err[U](err), "AndThenCtx")
+	}
+	return step(in.trace, nil, op(ctx, in.value), "AndThenCtx")
+}
+
+// MapCtx maps a `Result[T]` to `Result[U]` by applying a function to a contained [`Ok`] value
+// if the context is not done, leaving an [`Err`] value untouched.
+func MapCtx[T any, U any](ctx context.Context, r *Result[T], f func(context.Context, *T) *U) *Result[U] {
+	if r.IsErr() {
+		return step(r.trace, r.err, created(&Result[U]{err: r.err}), "MapCtx")
+	}
+	if err := ctx.Err(); err != nil {
+		return step(r.trace, nil, Err[U](err), "MapCtx")
+	}
+	return step(r.trace, nil, Ok(f(ctx, r.value)), "MapCtx")
+}
@@ -0,0 +1,35 @@
+package result
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	if !FromCtx(ctx).IsOk() {
+		t.Error("FromCtx failed")
+	}
+
+	x := 1
+	double := func(ctx context.Context, v *int) *int {
+		y := *v * 2
+		return &y
+	}
+	if *MapCtx(ctx, Ok(&x), double).Unwrap() != 2 {
+		t.Error("MapCtx failed")
+	}
+
+	cancel()
+	if !errors.Is(FromCtx(ctx).UnwrapError(), context.Canceled) {
+		t.Error("FromCtx failed")
+	}
+	r := AndThenCtx(ctx, Ok(&x), func(ctx context.Context, v *int) *Result[int] {
+		t.Error("AndThenCtx called op after cancellation")
+		return Ok(v)
+	})
+	if !errors.Is(r.UnwrapError(), context.Canceled) {
+		t.Error("AndThenCtx failed")
+	}
+}
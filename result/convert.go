@@ -0,0 +1,73 @@
+package result
+
+import "github.com/yuanzicheng/go-result-and-option/option"
+
+// OkOr transforms the option into a result, mapping [`Some(v)`] to [`Ok(v)`] and [`None`] to [`Err(err)`].
+// A nil `err` is replaced by option.ErrNone, so that a [`None`] never becomes an [`Ok`].
+func OkOr[T any](o *option.Option[T], err error) *Result[T] {
+	if v := o.Ptr(); v != nil {
+		return created(&Result[T]{value: v})
+	}
+	if err == nil {
+		err = option.ErrNone
+	}
+	fireErr(err, Created)
+	return created(&Result[T]{err: err})
+}
+
+// OkOrElse is like OkOr, but only calls `errFn` to get the error if the option is [`None`].
+func OkOrElse[T any](o *option.Option[T], errFn func() error) *Result[T] {
+	if v := o.Ptr(); v != nil {
+		return created(&Result[T]{value: v})
+	}
+	err := errFn()
+	if err == nil {
+		err = option.ErrNone
+	}
+	fireErr(err, Created)
+	return created(&Result[T]{err: err})
+}
+
+// Ok converts the result into an option of its value, discarding the error of an [`Err`].
+// An [`Ok`] of a nil value gives a [`None`] as well, since an option can't hold a nil pointer.
+func (r *Result[T]) Ok() *option.Option[T] {
+	if r.IsErr() {
+		return option.None[T]()
+	}
+	return option.New(r.value)
+}
+
+// Err converts the result into an option of its error, [`None`] for an [`Ok`].
+func (r *Result[T]) Err() *option.Option[error] {
+	if r.IsOk() {
+		return option.None[error]()
+	}
+	fireErr(r.err, Inspected)
+	err := r.err
+	return option.Some(&err)
+}
+
+// Transpose transposes a result of an option into an option of a result:
+// [`Ok(None)`] becomes [`None`], [`Ok(Some(v))`] becomes [`Some(Ok(v))`] and [`Err(e)`] becomes [`Some(Err(e))`].
+func Transpose[T any](r *Result[option.Option[T]]) *option.Option[Result[T]] {
+	if r.IsErr() {
+		return option.Some(&Result[T]{err: r.err, trace: r.trace})
+	}
+	if r.value == nil || r.value.IsNone() {
+		return option.None[Result[T]]()
+	}
+	return option.Some(&Result[T]{value: r.value.Ptr(), trace: r.trace})
+}
+
+// TransposeOption transposes an option of a result into a result of an option, the inverse of Transpose:
+// [`None`] becomes [`Ok(None)`], [`Some(Ok(v))`] becomes [`Ok(Some(v))`] and [`Some(Err(e))`] becomes [`Err(e)`].
+func TransposeOption[T any](o *option.Option[Result[T]]) *Result[option.Option[T]] {
+	r := o.Ptr()
+	if r == nil {
+		return created(&Result[option.Option[T]]{value: option.None[T]()})
+	}
+	if r.IsErr() {
+		return created(&Result[option.Option[T]]{err: r.err, trace: r.trace})
+	}
+	return created(&Result[option.Option[T]]{value: option.New(r.value), trace: r.trace})
+}
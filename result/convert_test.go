@@ -0,0 +1,88 @@
+package result
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+)
+
+func TestOkOr(t *testing.T) {
+	e := errors.New("missing")
+	v := 1
+	if r := OkOr(option.Some(&v), e); !r.IsOk() || *r.Unwrap() != 1 {
+		t.Error("Some should become Ok")
+	}
+	if r := OkOr(option.None[int](), e); !errors.Is(r.UnwrapError(), e) {
+		t.Error("None should become Err")
+	}
+	// Some(nil) is a None, so it gives the error too.
+	if r := OkOr(option.Some[int](nil), e); !errors.Is(r.UnwrapError(), e) {
+		t.Error("Some(nil) should become Err")
+	}
+	if r := OkOr(option.None[int](), nil); !errors.Is(r.UnwrapError(), option.ErrNone) {
+		t.Error("a nil error should become ErrNone")
+	}
+}
+
+func TestOkOrElse(t *testing.T) {
+	called := false
+	errFn := func() error { called = true; return errors.New("missing") }
+	v := 1
+	if r := OkOrElse(option.Some(&v), errFn); !r.IsOk() || called {
+		t.Error("Some should become Ok without calling errFn")
+	}
+	if r := OkOrElse(option.None[int](), errFn); !r.IsErr() || !called {
+		t.Error("None should become Err from errFn")
+	}
+}
+
+func TestOkErr(t *testing.T) {
+	e := errors.New("e")
+	if o := Ok(new(int)).Ok(); !o.IsSome() {
+		t.Error("Ok should give Some")
+	}
+	if o := Ok[int](nil).Ok(); !o.IsNone() {
+		t.Error("Ok(nil) should give None")
+	}
+	if o := Err[int](e).Ok(); !o.IsNone() {
+		t.Error("Err should give None")
+	}
+	if o := Err[int](e).Err(); !o.IsSome() || *o.Unwrap("") != e {
+		t.Error("Err should give Some error")
+	}
+	if o := Ok(new(int)).Err(); !o.IsNone() {
+		t.Error("Ok should give no error")
+	}
+}
+
+func TestTranspose(t *testing.T) {
+	e := errors.New("e")
+	v := 1
+	if o := Transpose(Ok(option.Some(&v))); !o.IsSome() || *o.Unwrap("").Unwrap() != 1 {
+		t.Error("Ok(Some) should give Some(Ok)")
+	}
+	if o := Transpose(Ok(option.None[int]())); !o.IsNone() {
+		t.Error("Ok(None) should give None")
+	}
+	if o := Transpose(Err[option.Option[int]](e)); !o.IsSome() || !errors.Is(o.Unwrap("").UnwrapError(), e) {
+		t.Error("Err should give Some(Err)")
+	}
+}
+
+func TestTransposeOption(t *testing.T) {
+	e := errors.New("e")
+	if r := TransposeOption(option.Some(Ok(new(int)))); !r.IsOk() || !r.Unwrap().IsSome() {
+		t.Error("Some(Ok) should give Ok(Some)")
+	}
+	if r := TransposeOption(option.None[Result[int]]()); !r.IsOk() || !r.Unwrap().IsNone() {
+		t.Error("None should give Ok(None)")
+	}
+	if r := TransposeOption(option.Some(Err[int](e))); !errors.Is(r.UnwrapError(), e) {
+		t.Error("Some(Err) should give Err")
+	}
+	// Round trip.
+	if r := TransposeOption(Transpose(Err[option.Option[int]](e))); !errors.Is(r.UnwrapError(), e) {
+		t.Error("Err should survive a round trip")
+	}
+}
@@ -0,0 +1,55 @@
+package result
+
+import (
+	"errors"
+	"time"
+)
+
+// Details are structured details of an error meant for API clients.
+type Details struct {
+	// Fields maps the names of the invalid fields to their error messages.
+	Fields map[string]string
+	// RetryAfter is the delay after which the request may be retried, if not zero.
+	RetryAfter time.Duration
+	// Value is the offending value, if any.
+	Value any
+}
+
+// DetailedError is an error carrying details.
+type DetailedError struct {
+	Details Details
+	Err     error
+}
+
+func (e *DetailedError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *DetailedError) Unwrap() error {
+	return e.Err
+}
+
+// WithDetails attaches the details to the error of an [`Err`], an [`Ok`] is returned unchanged.
+func (r *Result[T]) WithDetails(d Details) *Result[T] {
+	if r.IsOk() {
+		return r
+	}
+	return created(&Result[T]{err: &DetailedError{Details: d, Err: r.err}, trace: r.trace})
+}
+
+// DetailsOf returns the details of the error of an [`Err`], see ErrorDetails.
+func DetailsOf[T any](r *Result[T]) (Details, bool) {
+	if r.IsOk() {
+		return Details{}, false
+	}
+	return ErrorDetails(r.err)
+}
+
+// ErrorDetails returns the details of the first DetailedError in the chain of `err`.
+func ErrorDetails(err error) (Details, bool) {
+	var e *DetailedError
+	if errors.As(err, &e) {
+		return e.Details, true
+	}
+	return Details{}, false
+}
@@ -0,0 +1,27 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestDetails(t *testing.T) {
+	boom := errors.New("boom")
+	r := Err[int](boom).WithDetails(Details{RetryAfter: time.Second, Value: 42})
+	d, ok := DetailsOf(r)
+	if !ok || d.RetryAfter != time.Second || d.Value != 42 || !errors.Is(r.UnwrapError(), boom) {
+		t.Error("DetailsOf failed")
+	}
+	if d, ok := ErrorDetails(fmt.Errorf("wrapped: %w", r.UnwrapError())); !ok || d.Value != 42 {
+		t.Error("ErrorDetails failed on a wrapped error")
+	}
+	x := 1
+	if _, ok := DetailsOf(Ok(&x).WithDetails(Details{Value: 1})); ok {
+		t.Error("WithDetails failed on Ok")
+	}
+	if _, ok := DetailsOf(Err[int](boom)); ok {
+		t.Error("DetailsOf failed without details")
+	}
+}
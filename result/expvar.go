@@ -0,0 +1,97 @@
+package result
+
+import (
+	"expvar"
+	"html/template"
+	"net/http"
+	"sort"
+	"sync"
+	"sync/atomic"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/option"
+)
+
+var (
+	counting    atomic.Bool
+	counters    = new(expvar.Map).Init()
+	errByKind   = new(expvar.Map).Init()
+	publishOnce sync.Once
+)
+
+// Expvar enables the counting of the results created, published as the expvar variable "result"
+// with the counters "ok", "err" and "err_by_kind", the latter by errkind.Kind, "unknown" standing
+// for errors without kind, and returns it. The options are counted too, see option.Expvar.
+//
+// Results propagated by the combinators, e.g. the [`Err`] returned by AndThen, count as new results.
+func Expvar() *expvar.Map {
+	publishOnce.Do(func() {
+		counters.Set("err_by_kind", errByKind)
+		expvar.Publish("result", counters)
+		option.Expvar()
+		counting.Store(true)
+	})
+	return counters
+}
+
+func count(err error) {
+	if err == nil {
+		counters.Add("ok", 1)
+		return
+	}
+	counters.Add("err", 1)
+	kind := errkind.KindOf(err)
+	if kind == "" {
+		kind = "unknown"
+	}
+	errByKind.Add(string(kind), 1)
+}
+
+var diagnostics = template.Must(template.New("diagnostics").Parse(`<!DOCTYPE html>
+<html>
+<head><title>Results</title></head>
+<body>
+{{range .}}<h2>{{.Name}}</h2>
+<table>
+{{range .Counters}}<tr><td>{{.Name}}</td><td>{{.Value}}</td></tr>
+{{end}}</table>
+{{end}}</body>
+</html>
+`))
+
+type counter struct {
+	Name  string
+	Value string
+}
+
+// ExpvarHandler returns a handler of a diagnostics page listing the counters of Expvar,
+// which it enables.
+func ExpvarHandler() http.Handler {
+	Expvar()
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		type section struct {
+			Name     string
+			Counters []counter
+		}
+		sections := []section{
+			{"Results", list(counters, "")},
+			{"Options", list(expvar.Get("option").(*expvar.Map), "")},
+		}
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		diagnostics.Execute(w, sections)
+	})
+}
+
+// list returns the counters of the map sorted by name, flattening nested maps.
+func list(m *expvar.Map, prefix string) []counter {
+	var cs []counter
+	m.Do(func(kv expvar.KeyValue) {
+		if sub, ok := kv.Value.(*expvar.Map); ok {
+			cs = append(cs, list(sub, prefix+kv.Key+".")...)
+			return
+		}
+		cs = append(cs, counter{Name: prefix + kv.Key, Value: kv.Value.String()})
+	})
+	sort.Slice(cs, func(i, j int) bool { return cs[i].Name < cs[j].Name })
+	return cs
+}
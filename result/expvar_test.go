@@ -0,0 +1,33 @@
+package result
+
+import (
+	"errors"
+	"expvar"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+)
+
+func TestExpvar(t *testing.T) {
+	m := Expvar()
+	if expvar.Get("result") != m || expvar.Get("option") == nil {
+		t.Fatal("Expvar failed to publish the counters")
+	}
+	x := 1
+	Ok(&x)
+	ErrOfKind[int](errkind.NotFound, errors.New("no such user"))
+	Err[int](errors.New("boom"))
+	byKind := m.Get("err_by_kind").(*expvar.Map)
+	if m.Get("ok") == nil || byKind.Get("not_found") == nil || byKind.Get("unknown") == nil {
+		t.Errorf("Expvar failed to count: %s", m)
+	}
+
+	w := httptest.NewRecorder()
+	ExpvarHandler().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/debug/results", nil))
+	if body := w.Body.String(); !strings.Contains(body, "<td>err_by_kind.not_found</td>") {
+		t.Errorf("ExpvarHandler failed: %s", body)
+	}
+}
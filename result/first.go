@@ -0,0 +1,24 @@
+package result
+
+import "errors"
+
+// ErrNoResults is the error of the [`Err`] returned by FirstOk without any result.
+var ErrNoResults = errors.New("result: no results")
+
+// FirstOk returns the first [`Ok`] of the results, or an [`Err`] joining the errors of all of them
+// with errors.Join. Without any result it returns an [`Err`] of ErrNoResults.
+func FirstOk[T any](rs ...*Result[T]) *Result[T] {
+	errs := make([]error, 0, len(rs))
+	for _, r := range rs {
+		if r.IsOk() {
+			return r
+		}
+		errs = append(errs, r.err)
+	}
+	err := ErrNoResults
+	if len(errs) > 0 {
+		err = errors.Join(errs...)
+	}
+	fireErr(err, Created)
+	return created(&Result[T]{err: err})
+}
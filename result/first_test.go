@@ -0,0 +1,20 @@
+package result
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFirstOk(t *testing.T) {
+	a, b := errors.New("a"), errors.New("b")
+	x, y := 1, 2
+	if *FirstOk(Err[int](a), Ok(&x), Ok(&y)).Unwrap() != 1 {
+		t.Error("FirstOk did not return the first Ok")
+	}
+	if err := FirstOk(Err[int](a), Err[int](b)).UnwrapError(); !errors.Is(err, a) || !errors.Is(err, b) {
+		t.Errorf("FirstOk did not join the errors: %v", err)
+	}
+	if !errors.Is(FirstOk[int]().UnwrapError(), ErrNoResults) {
+		t.Error("FirstOk without results failed")
+	}
+}
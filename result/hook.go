@@ -0,0 +1,67 @@
+package result
+
+import (
+	"context"
+	"log/slog"
+	"runtime"
+	"sync/atomic"
+)
+
+// Event is the event an error hook is called for.
+type Event int
+
+const (
+	// Created is the creation of an [`Err`] by Err, ErrPooled or New.
+	Created Event = iota
+	// Inspected is the consumption of the error of an [`Err`] by UnwrapError or InspectErr,
+	// or its replacement by a fallback value by UnwrapOr, UnwrapOrElse or UnwrapOrDefault.
+	Inspected
+)
+
+func (e Event) String() string {
+	if e == Created {
+		return "created"
+	}
+	return "inspected"
+}
+
+// Meta describes the event an error hook is called for.
+type Meta struct {
+	Event Event
+	// File and Line are the location of the call creating or inspecting the [`Err`].
+	File string
+	Line int
+}
+
+var errHook atomic.Pointer[func(error, Meta)]
+
+// SetErrHook sets the hook called with the error of every [`Err`] created or inspected,
+// replacing any previous one. A nil hook removes it.
+// Errors propagated by the combinators, e.g. AndThen, don't call the hook again,
+// and neither do the other packages of this module when they only observe a result,
+// e.g. to sort, hash or print it.
+func SetErrHook(hook func(err error, meta Meta)) {
+	if hook == nil {
+		errHook.Store(nil)
+		return
+	}
+	errHook.Store(&hook)
+}
+
+// fireErr calls the error hook, if any, with the location of the caller of the function calling fireErr.
+func fireErr(err error, event Event) {
+	hook := errHook.Load()
+	if hook == nil {
+		return
+	}
+	_, file, line, _ := runtime.Caller(2)
+	(*hook)(err, Meta{Event: event, File: file, Line: line})
+}
+
+// SlogHook returns an error hook logging the events with the logger at the level.
+func SlogHook(logger *slog.Logger, level slog.Level) func(error, Meta) {
+	return func(err error, meta Meta) {
+		logger.Log(context.Background(), level, "result: "+meta.Event.String()+" Err",
+			slog.Any("error", err), slog.String("file", meta.File), slog.Int("line", meta.Line))
+	}
+}
@@ -0,0 +1,48 @@
+package result
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"log/slog"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestSetErrHook(t *testing.T) {
+	var metas []Meta
+	SetErrHook(func(err error, meta Meta) {
+		if err != io.EOF {
+			t.Errorf("SetErrHook got %v", err)
+		}
+		metas = append(metas, meta)
+	})
+	defer SetErrHook(nil)
+
+	r := AndThen(Err[int](io.EOF), func(x *int) *Result[int] { return Ok(x) })
+	r.UnwrapError()
+	if len(metas) != 2 || metas[0].Event != Created || metas[1].Event != Inspected {
+		t.Fatalf("SetErrHook failed: %v", metas)
+	}
+	if filepath.Base(metas[0].File) != "hook_test.go" || metas[0].Line != 23 || metas[1].Line != 24 {
+		t.Errorf("SetErrHook failed: %v", metas)
+	}
+
+	SetErrHook(nil)
+	Err[int](io.EOF)
+	if len(metas) != 2 {
+		t.Error("SetErrHook failed to remove the hook")
+	}
+}
+
+func TestSlogHook(t *testing.T) {
+	var b bytes.Buffer
+	SetErrHook(SlogHook(slog.New(slog.NewTextHandler(&b, nil)), slog.LevelWarn))
+	defer SetErrHook(nil)
+
+	Err[int](errors.New("boom"))
+	if !strings.Contains(b.String(), `msg="result: created Err" error=boom`) {
+		t.Errorf("SlogHook failed: %s", b.String())
+	}
+}
@@ -0,0 +1,168 @@
+package result
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+)
+
+// JSONOpt configures the JSON encoding of results by MarshalJSONWith and UnmarshalJSONWith.
+type JSONOpt func(*jsonConfig)
+
+type jsonConfig struct {
+	bare, structured, strict bool
+}
+
+// JSONAcceptBare makes decoding accept a value which isn't an `{"ok": ...}` or `{"err": ...}`
+// envelope as an [`Ok`] of it. An object with an ok or err member is still decoded as an envelope.
+func JSONAcceptBare() JSONOpt {
+	return func(c *jsonConfig) {
+		c.bare = true
+	}
+}
+
+// JSONStructuredErr makes encoding write the error of an [`Err`] as an object with its message,
+// its kind and its details, see ErrorDetails, rather than as its message only.
+// Decoding accepts both forms regardless.
+func JSONStructuredErr() JSONOpt {
+	return func(c *jsonConfig) {
+		c.structured = true
+	}
+}
+
+// JSONStrict makes decoding fail on unknown members: in the envelope, in a structured error,
+// and in the [`Ok`] value as `json.Decoder.DisallowUnknownFields` does.
+func JSONStrict() JSONOpt {
+	return func(c *jsonConfig) {
+		c.strict = true
+	}
+}
+
+// jsonErr is the structured encoding of an error.
+type jsonErr struct {
+	Message    string            `json:"message"`
+	Kind       errkind.Kind      `json:"kind,omitempty"`
+	Fields     map[string]string `json:"fields,omitempty"`
+	Value      any               `json:"value,omitempty"`
+	RetryAfter int               `json:"retry_after,omitempty"`
+}
+
+// MarshalJSONWith encodes the result as the object `{"ok": value}` for an [`Ok`], or `{"err": message}`
+// for an [`Err`], like MarshalYAML, with the error as an object under JSONStructuredErr.
+func MarshalJSONWith[T any](r *Result[T], opts ...JSONOpt) ([]byte, error) {
+	c := jsonConfigOf(opts)
+	if r.err == nil {
+		return json.Marshal(struct {
+			Ok *T `json:"ok"`
+		}{r.value})
+	}
+	if !c.structured {
+		return json.Marshal(struct {
+			Err string `json:"err"`
+		}{r.err.Error()})
+	}
+	e := jsonErr{Message: r.err.Error(), Kind: errkind.KindOf(r.err)}
+	if d, ok := ErrorDetails(r.err); ok {
+		e.Fields, e.Value = d.Fields, d.Value
+		e.RetryAfter = int((d.RetryAfter + time.Second - 1) / time.Second)
+	}
+	return json.Marshal(struct {
+		Err jsonErr `json:"err"`
+	}{e})
+}
+
+// UnmarshalJSONWith decodes a result encoded by MarshalJSONWith into `r`. The error of an [`Err`] is
+// decoded as an error with the same message, tagged with its kind and carrying its details if structured.
+// Members other than ok and err are ignored, unless JSONStrict is given.
+func UnmarshalJSONWith[T any](data []byte, r *Result[T], opts ...JSONOpt) error {
+	c := jsonConfigOf(opts)
+	var env map[string]json.RawMessage
+	isObject := json.Unmarshal(data, &env) == nil && env != nil
+	okRaw, hasOk := env["ok"]
+	errRaw, hasErr := env["err"]
+	if !isObject || !hasOk && !hasErr {
+		if !c.bare {
+			return errors.New("result: JSON value must be an object with ok or err")
+		}
+		v := new(T)
+		if err := c.decode(data, v); err != nil {
+			return err
+		}
+		r.value, r.err = v, nil
+		return nil
+	}
+	if hasOk && hasErr {
+		return errors.New("result: JSON object must have exactly one of ok and err")
+	}
+	if c.strict && len(env) > 1 {
+		return errors.New("result: JSON object has unknown members")
+	}
+	if hasOk {
+		var v *T
+		if err := c.decode(okRaw, &v); err != nil {
+			return err
+		}
+		r.value, r.err = v, nil
+		return nil
+	}
+
+	if bytes.Equal(errRaw, []byte("null")) {
+		return errors.New("result: JSON err member must be a string or an error object")
+	}
+	var msg string
+	if err := json.Unmarshal(errRaw, &msg); err == nil {
+		r.value, r.err = nil, errors.New(msg)
+		return nil
+	}
+	var e jsonErr
+	if err := c.decode(errRaw, &e); err != nil {
+		return errors.New("result: JSON err member must be a string or an error object")
+	}
+	r.value, r.err = nil, e.toError()
+	return nil
+}
+
+// toError returns the error encoded by `e`, tagged with its kind and carrying its details if any.
+func (e jsonErr) toError() error {
+	err := errkind.Wrap(e.Kind, errors.New(e.Message))
+	if e.Kind == "" {
+		err = errors.New(e.Message)
+	}
+	if len(e.Fields) > 0 || e.Value != nil || e.RetryAfter > 0 {
+		err = &DetailedError{
+			Details: Details{Fields: e.Fields, Value: e.Value, RetryAfter: time.Duration(e.RetryAfter) * time.Second},
+			Err:     err,
+		}
+	}
+	return err
+}
+
+// MarshalJSON encodes the result as MarshalJSONWith does without options,
+// as `{"ok": value}` for an [`Ok`] or `{"err": message}` for an [`Err`].
+func (r Result[T]) MarshalJSON() ([]byte, error) {
+	return MarshalJSONWith(&r)
+}
+
+// UnmarshalJSON decodes a result as UnmarshalJSONWith does without options.
+func (r *Result[T]) UnmarshalJSON(data []byte) error {
+	return UnmarshalJSONWith(data, r)
+}
+
+func jsonConfigOf(opts []JSONOpt) jsonConfig {
+	var c jsonConfig
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+func (c jsonConfig) decode(data []byte, v any) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if c.strict {
+		dec.DisallowUnknownFields()
+	}
+	return dec.Decode(v)
+}
@@ -0,0 +1,95 @@
+package result
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+)
+
+type jsonUser struct {
+	Name string `json:"name"`
+}
+
+func TestMarshalJSONWith(t *testing.T) {
+	u := jsonUser{Name: "ann"}
+	if b, err := MarshalJSONWith(Ok(&u)); err != nil || string(b) != `{"ok":{"name":"ann"}}` {
+		t.Errorf("Ok = %s, %v", b, err)
+	}
+	r := ErrOfKind[jsonUser](errkind.Invalid, errors.New("bad name")).
+		WithDetails(Details{Fields: map[string]string{"name": "required"}, RetryAfter: 1500 * time.Millisecond})
+	if b, err := MarshalJSONWith(r); err != nil || string(b) != `{"err":"bad name"}` {
+		t.Errorf("Err = %s, %v", b, err)
+	}
+	b, err := MarshalJSONWith(r, JSONStructuredErr())
+	if err != nil || string(b) != `{"err":{"message":"bad name","kind":"invalid","fields":{"name":"required"},"retry_after":2}}` {
+		t.Errorf("structured Err = %s, %v", b, err)
+	}
+
+	var back Result[jsonUser]
+	if err := UnmarshalJSONWith(b, &back); err != nil {
+		t.Fatal(err)
+	}
+	d, _ := DetailsOf(&back)
+	if back.Kind() != errkind.Invalid || back.UnwrapError().Error() != "bad name" || d.Fields["name"] != "required" || d.RetryAfter != 2*time.Second {
+		t.Errorf("structured Err did not round-trip: %v %+v", back.UnwrapError(), d)
+	}
+}
+
+func TestUnmarshalJSONWith(t *testing.T) {
+	var r Result[jsonUser]
+	if err := UnmarshalJSONWith([]byte(`{"ok":{"name":"ann"},"meta":1}`), &r); err != nil || r.Unwrap().Name != "ann" {
+		t.Errorf("Ok failed: %v", err)
+	}
+	if err := UnmarshalJSONWith([]byte(`{"err":"boom"}`), &r); err != nil || r.UnwrapError().Error() != "boom" {
+		t.Errorf("Err failed: %v", err)
+	}
+	for _, bad := range []string{`{"name":"ann"}`, `{"ok":1,"err":"x"}`, `{"err":null}`, `{"err":3}`, `[]`, `null`} {
+		if err := UnmarshalJSONWith([]byte(bad), &r); err == nil {
+			t.Errorf("%s was accepted", bad)
+		}
+	}
+
+	if err := UnmarshalJSONWith([]byte(`{"name":"bob"}`), &r, JSONAcceptBare()); err != nil || r.Unwrap().Name != "bob" {
+		t.Errorf("bare value failed: %v", err)
+	}
+	var n Result[int]
+	if err := UnmarshalJSONWith([]byte(`3`), &n, JSONAcceptBare()); err != nil || *n.Unwrap() != 3 {
+		t.Errorf("bare number failed: %v", err)
+	}
+
+	for _, bad := range []string{`{"ok":{"name":"ann"},"meta":1}`, `{"ok":{"name":"ann","age":1}}`, `{"err":{"message":"x","code":1}}`} {
+		if err := UnmarshalJSONWith([]byte(bad), &r, JSONStrict()); err == nil {
+			t.Errorf("%s was accepted in strict mode", bad)
+		}
+	}
+	if err := UnmarshalJSONWith([]byte(`{"ok":{"name":"ann"}}`), &r, JSONStrict()); err != nil {
+		t.Errorf("strict mode rejected a valid envelope: %v", err)
+	}
+}
+
+func TestResultJSONField(t *testing.T) {
+	type dto struct {
+		A Result[int] `json:"a"`
+		B Result[int] `json:"b"`
+	}
+	b, err := json.Marshal(dto{A: *Ok(new(int)), B: *Err[int](errors.New("e"))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"a":{"ok":0},"b":{"err":"e"}}`; string(b) != want {
+		t.Errorf("got %s, want %s", b, want)
+	}
+	var d dto
+	if err := json.Unmarshal(b, &d); err != nil {
+		t.Fatal(err)
+	}
+	if *d.A.Unwrap() != 0 || d.B.UnwrapError().Error() != "e" {
+		t.Errorf("decoded %+v", d)
+	}
+	if err := json.Unmarshal([]byte(`{"a":1}`), &d); err == nil {
+		t.Error("a bare value should fail")
+	}
+}
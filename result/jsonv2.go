@@ -0,0 +1,99 @@
+//go:build go1.27 && goexperiment.jsonv2
+
+package result
+
+import (
+	"encoding/json/jsontext"
+	"encoding/json/v2"
+	"errors"
+)
+
+// MarshalJSONTo encodes the result as the object `{"ok": value}` for an [`Ok`], or `{"err": message}`
+// for an [`Err`], like MarshalYAML. It implements the `MarshalerTo` interface of encoding/json/v2.
+func (r Result[T]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if err := enc.WriteToken(jsontext.BeginObject); err != nil {
+		return err
+	}
+	if r.err != nil {
+		if err := enc.WriteToken(jsontext.String("err")); err != nil {
+			return err
+		}
+		if err := enc.WriteToken(jsontext.String(r.err.Error())); err != nil {
+			return err
+		}
+	} else {
+		if err := enc.WriteToken(jsontext.String("ok")); err != nil {
+			return err
+		}
+		if err := json.MarshalEncode(enc, r.value); err != nil {
+			return err
+		}
+	}
+	return enc.WriteToken(jsontext.EndObject)
+}
+
+// UnmarshalJSONFrom decodes an object encoded by MarshalJSONTo or MarshalJSONWith, the error of an [`Err`]
+// being decoded as an error with the same message, tagged with its kind and carrying its details if structured.
+// Other members are ignored.
+// It implements the `UnmarshalerFrom` interface of encoding/json/v2.
+func (r *Result[T]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	tok, err := dec.ReadToken()
+	if err != nil {
+		return err
+	}
+	if tok.Kind() != '{' {
+		return errors.New("result: JSON value must be an object")
+	}
+	var (
+		value         *T
+		errValue      error
+		hasOk, hasErr bool
+	)
+	for dec.PeekKind() != '}' {
+		name, err := dec.ReadToken()
+		if err != nil {
+			return err
+		}
+		switch name.String() {
+		case "ok":
+			hasOk = true
+			if err := json.UnmarshalDecode(dec, &value); err != nil {
+				return err
+			}
+		case "err":
+			hasErr = true
+			switch dec.PeekKind() {
+			case '"':
+				tok, err := dec.ReadToken()
+				if err != nil {
+					return err
+				}
+				errValue = errors.New(tok.String())
+			case '{':
+				var e jsonErr
+				if err := json.UnmarshalDecode(dec, &e); err != nil {
+					return err
+				}
+				errValue = e.toError()
+			default:
+				return errors.New("result: JSON err member must be a string or an error object")
+			}
+		default:
+			if err := dec.SkipValue(); err != nil {
+				return err
+			}
+		}
+	}
+	if _, err := dec.ReadToken(); err != nil {
+		return err
+	}
+	if hasOk == hasErr {
+		return errors.New("result: JSON object must have exactly one of ok and err")
+	}
+	if hasErr {
+		r.value, r.err = nil, errValue
+	} else {
+		r.value, r.err = value, nil
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+//go:build go1.27 && goexperiment.jsonv2
+
+package result
+
+import (
+	"encoding/json/v2"
+	"errors"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+)
+
+func TestJSONv2(t *testing.T) {
+	x := 1
+	b, err := json.Marshal(Ok(&x))
+	if err != nil || string(b) != `{"ok":1}` {
+		t.Errorf("Marshal of Ok = %s, %v", b, err)
+	}
+	b, err = json.Marshal(Err[int](errors.New("boom")))
+	if err != nil || string(b) != `{"err":"boom"}` {
+		t.Errorf("Marshal of Err = %s, %v", b, err)
+	}
+
+	var r Result[int]
+	if err := json.Unmarshal([]byte(`{"ok":2,"trace":[]}`), &r); err != nil || *r.Unwrap() != 2 {
+		t.Errorf("Unmarshal of Ok failed: %v", err)
+	}
+	if err := json.Unmarshal([]byte(`{"err":"boom"}`), &r); err != nil || r.UnwrapError().Error() != "boom" {
+		t.Errorf("Unmarshal of Err failed: %v", err)
+	}
+	if err := json.Unmarshal([]byte(`{"err":{"message":"boom","kind":"invalid","fields":{"name":"required"}}}`), &r); err != nil {
+		t.Errorf("Unmarshal of a structured Err failed: %v", err)
+	} else if d, _ := ErrorDetails(r.UnwrapError()); r.UnwrapError().Error() != "boom" || errkind.KindOf(r.UnwrapError()) != errkind.Invalid || d.Fields["name"] != "required" {
+		t.Errorf("Unmarshal of a structured Err = %v", r.UnwrapError())
+	}
+	for _, bad := range []string{`{}`, `{"ok":1,"err":"boom"}`, `{"err":1}`, `[1]`} {
+		if err := json.Unmarshal([]byte(bad), &r); err == nil {
+			t.Errorf("Unmarshal accepted %s", bad)
+		}
+	}
+}
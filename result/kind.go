@@ -0,0 +1,35 @@
+package result
+
+import (
+	"fmt"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+)
+
+// ErrOfKind returns an [`Err`] of the error tagged with the kind, see errkind.Wrap.
+func ErrOfKind[T any](kind errkind.Kind, err error) *Result[T] {
+	err = errkind.Wrap(kind, err)
+	if err != nil {
+		fireErr(err, Created)
+	}
+	return created(&Result[T]{err: err})
+}
+
+// Errf returns an [`Err`] of the error formatted like `fmt.Errorf`, `%w` included.
+// The kind of an error wrapped with `%w`, or a kind wrapped directly, becomes the kind of the result:
+//
+//	result.Errf[User]("loading user %d: %w", id, errkind.NotFound).Kind() // errkind.NotFound
+func Errf[T any](format string, args ...any) *Result[T] {
+	err := fmt.Errorf(format, args...)
+	fireErr(err, Created)
+	return created(&Result[T]{err: err})
+}
+
+// Kind returns the kind of the error of an [`Err`], see errkind.KindOf,
+// or the empty kind for an [`Ok`].
+func (r *Result[T]) Kind() errkind.Kind {
+	if r.IsOk() {
+		return ""
+	}
+	return errkind.KindOf(r.err)
+}
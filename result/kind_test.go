@@ -0,0 +1,36 @@
+package result
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+)
+
+func TestKind(t *testing.T) {
+	r := ErrOfKind[int](errkind.NotFound, errors.New("no such user"))
+	if r.Kind() != errkind.NotFound || !errors.Is(r.UnwrapError(), errkind.NotFound) {
+		t.Error("ErrOfKind failed")
+	}
+	x := 1
+	if Ok(&x).Kind() != "" || Err[int](errors.New("boom")).Kind() != "" {
+		t.Error("Kind failed")
+	}
+}
+
+func TestErrf(t *testing.T) {
+	sentinel := errkind.New(errkind.Conflict, "already exists")
+	r := Errf[int]("creating user %d: %w", 7, sentinel)
+	if r.UnwrapError().Error() != "creating user 7: already exists" || !errors.Is(r.UnwrapError(), sentinel) {
+		t.Error("Errf failed")
+	}
+	if r.Kind() != errkind.Conflict {
+		t.Error("Errf did not keep the kind of the wrapped error")
+	}
+	if Errf[int]("user %d: %w", 7, errkind.NotFound).Kind() != errkind.NotFound {
+		t.Error("Errf did not keep a wrapped kind")
+	}
+	if Errf[int]("plain").Kind() != "" {
+		t.Error("Errf without a kind has one")
+	}
+}
@@ -0,0 +1,22 @@
+package result
+
+// Kleisli composes same-type fallible steps into one function calling them in turn,
+// each one with the [`Ok`] value of the previous one, stopping at the first [`Err`].
+// Without steps, the function is Identity.
+func Kleisli[T any](fs ...func(*T) *Result[T]) func(*T) *Result[T] {
+	return func(v *T) *Result[T] {
+		r := Ok(v)
+		for _, f := range fs {
+			if r.IsErr() {
+				break
+			}
+			r = f(r.value)
+		}
+		return r
+	}
+}
+
+// Identity returns [`Ok`] of `v`, it is the neutral step of Kleisli.
+func Identity[T any](v *T) *Result[T] {
+	return Ok(v)
+}
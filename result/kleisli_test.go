@@ -0,0 +1,33 @@
+package result
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestKleisli(t *testing.T) {
+	trim := func(s *string) *Result[string] {
+		v := strings.TrimSpace(*s)
+		return Ok(&v)
+	}
+	nonEmpty := func(s *string) *Result[string] {
+		if *s == "" {
+			return Err[string](errors.New("empty"))
+		}
+		return Ok(s)
+	}
+	upper := func(s *string) *Result[string] {
+		v := strings.ToUpper(*s)
+		return Ok(&v)
+	}
+
+	normalize := Kleisli(trim, nonEmpty, upper)
+	s, blank := " ab ", "  "
+	if *normalize(&s).Unwrap() != "AB" || normalize(&blank).IsOk() {
+		t.Error("Kleisli failed")
+	}
+	if Kleisli[string]()(&s).Unwrap() != &s || Identity(&s).Unwrap() != &s {
+		t.Error("Identity failed")
+	}
+}
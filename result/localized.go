@@ -0,0 +1,65 @@
+package result
+
+import "errors"
+
+// Message is a user-facing message to localize: a key into a catalog of translations and its parameters.
+type Message struct {
+	Key  string
+	Args map[string]any
+}
+
+// LocalizedError carries a user-facing message besides the technical error meant for logs.
+type LocalizedError struct {
+	Message Message
+	Err     error
+}
+
+// Error returns the technical error message, or the message key without error.
+func (e *LocalizedError) Error() string {
+	if e.Err == nil {
+		return e.Message.Key
+	}
+	return e.Err.Error()
+}
+
+func (e *LocalizedError) Unwrap() error {
+	return e.Err
+}
+
+// ErrL returns an [`Err`] carrying the message of `key` with `args` for users, besides `err` for logs,
+// which may be nil.
+func ErrL[T any](key string, args map[string]any, err error) *Result[T] {
+	e := &LocalizedError{Message: Message{Key: key, Args: args}, Err: err}
+	fireErr(e, Created)
+	return created(&Result[T]{err: e})
+}
+
+// Localizer renders messages, typically in the language of a request.
+type Localizer interface {
+	Localize(msg Message) string
+}
+
+// LocalizerFunc is a function implementing Localizer.
+type LocalizerFunc func(msg Message) string
+
+func (f LocalizerFunc) Localize(msg Message) string {
+	return f(msg)
+}
+
+// MessageOf returns the message of the first LocalizedError in the chain of `err`.
+func MessageOf(err error) (Message, bool) {
+	var e *LocalizedError
+	if errors.As(err, &e) {
+		return e.Message, true
+	}
+	return Message{}, false
+}
+
+// Localize renders the message of the error with the localizer, or returns `fallback`
+// if the error doesn't carry one, so technical messages don't reach users.
+func Localize(err error, l Localizer, fallback string) string {
+	if msg, ok := MessageOf(err); ok {
+		return l.Localize(msg)
+	}
+	return fallback
+}
@@ -0,0 +1,29 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestErrL(t *testing.T) {
+	cause := errors.New("pq: duplicate key value violates unique constraint")
+	r := ErrL[int]("user.email_taken", map[string]any{"email": "a@example.com"}, cause)
+	err := fmt.Errorf("signing up: %w", r.UnwrapError())
+	if !errors.Is(err, cause) || err.Error() != "signing up: "+cause.Error() {
+		t.Error("ErrL lost the technical error")
+	}
+
+	fr := LocalizerFunc(func(msg Message) string {
+		return fmt.Sprintf("L'adresse %s est déjà utilisée", msg.Args["email"])
+	})
+	if got := Localize(err, fr, "Erreur"); got != "L'adresse a@example.com est déjà utilisée" {
+		t.Errorf("Localize failed: %s", got)
+	}
+	if got := Localize(cause, fr, "Erreur"); got != "Erreur" {
+		t.Errorf("Localize failed to fall back: %s", got)
+	}
+	if ErrL[int]("key", nil, nil).UnwrapError().Error() != "key" {
+		t.Error("ErrL failed without error")
+	}
+}
@@ -0,0 +1,45 @@
+package result
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+)
+
+var matchers sync.Map // map[string]func(error) bool
+
+// RegisterMatcher registers a predicate on errors under a name, replacing any previous one,
+// so sentinel errors and third-party error types can be matched with IsErrKind.
+// A nil matcher removes it.
+//
+// Registering a matcher under the name of an errkind.Kind, e.g. "not_found",
+// extends the kind: errors of the kind keep matching.
+func RegisterMatcher(name string, match func(error) bool) {
+	if match == nil {
+		matchers.Delete(name)
+		return
+	}
+	matchers.Store(name, match)
+}
+
+// IsErrKind returns `true` if the result is [`Err`] and its error matches the matcher
+// registered under the name, or is of the errkind.Kind of that name.
+func (r *Result[T]) IsErrKind(name string) bool {
+	if r.IsOk() {
+		return false
+	}
+	if m, ok := matchers.Load(name); ok && m.(func(error) bool)(r.err) {
+		return true
+	}
+	return errors.Is(r.err, errkind.Kind(name))
+}
+
+// OrElseKind calls `op` if the result is an [`Err`] matching the name, see IsErrKind,
+// otherwise returns the result.
+func (r *Result[T]) OrElseKind(name string, op func(error) *Result[T]) *Result[T] {
+	if !r.IsErrKind(name) {
+		return r
+	}
+	return op(r.err)
+}
@@ -0,0 +1,33 @@
+package result
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+)
+
+func TestIsErrKind(t *testing.T) {
+	errNoRows := errors.New("no rows")
+	RegisterMatcher("not_found", func(err error) bool { return errors.Is(err, errNoRows) })
+	defer RegisterMatcher("not_found", nil)
+
+	if !Err[int](errNoRows).IsErrKind("not_found") {
+		t.Error("IsErrKind failed on a registered matcher")
+	}
+	if !ErrOfKind[int](errkind.NotFound, errors.New("no such user")).IsErrKind("not_found") {
+		t.Error("IsErrKind failed on a kind")
+	}
+	x := 1
+	if Ok(&x).IsErrKind("not_found") || Err[int](errors.New("boom")).IsErrKind("not_found") {
+		t.Error("IsErrKind matched")
+	}
+
+	r := Err[int](errNoRows).OrElseKind("not_found", func(error) *Result[int] { return Ok(&x) })
+	if !r.IsOk() {
+		t.Error("OrElseKind failed")
+	}
+	if Err[int](errors.New("boom")).OrElseKind("not_found", func(error) *Result[int] { return Ok(&x) }).IsOk() {
+		t.Error("OrElseKind recovered a mismatched error")
+	}
+}
@@ -0,0 +1,73 @@
+package result
+
+import (
+	"fmt"
+	"runtime/debug"
+	"sync/atomic"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+)
+
+// PanicError is the error of an [`Err`] recovered from a panic by Catch.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Unwrap returns the panic value if it is an error.
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// Catch calls `f` and returns its result, converting a panic into an [`Err`] of `*PanicError`.
+func Catch[T any](f func() *Result[T]) (r *Result[T]) {
+	defer func() {
+		if v := recover(); v != nil {
+			r = Err[T](&PanicError{Value: v, Stack: debug.Stack()})
+		}
+	}()
+	return f()
+}
+
+var panicHandler atomic.Pointer[func(string, error)]
+
+// SetPanicHandler sets the handler called instead of panicking by Unwrap, Expect, UnwrapError,
+// ExpectErr and PropagateErr, replacing any previous one, and installs it for options too,
+// see option.SetPanicHandler, with a nil error. A nil handler removes it, restoring the panics.
+//
+// The handler is called with the panic message and the error of the [`Err`], if any. It may log
+// the failure, panic itself or exit; if it returns, the method returns a nil value or error,
+// and PropagateErr an [`Err`] of the message.
+func SetPanicHandler(h func(msg string, err error)) {
+	if h == nil {
+		panicHandler.Store(nil)
+		option.SetPanicHandler(nil)
+		return
+	}
+	panicHandler.Store(&h)
+	option.SetPanicHandler(func(msg string) { h(msg, nil) })
+}
+
+// fail panics with the message, or calls the panic handler instead if there is one.
+func fail(msg string, err error) {
+	if h := panicHandler.Load(); h != nil {
+		(*h)(msg, err)
+		return
+	}
+	panic(msg)
+}
+
+// UnwrapChecked returns the contained [`Ok`] value and nil, or nil and the error of an [`Err`].
+// It never panics.
+func (r *Result[T]) UnwrapChecked() (*T, error) {
+	if r.IsErr() {
+		fireErr(r.err, Inspected)
+		return nil, r.err
+	}
+	return r.value, nil
+}
@@ -0,0 +1,67 @@
+package result
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+)
+
+func TestCatch(t *testing.T) {
+	r := Catch(func() *Result[int] {
+		panic("boom")
+	})
+	var pe *PanicError
+	if !errors.As(r.UnwrapError(), &pe) || pe.Value != "boom" {
+		t.Error("Catch failed")
+	}
+
+	x := 1
+	if *Catch(func() *Result[int] { return Ok(&x) }).Unwrap() != 1 {
+		t.Error("Catch failed")
+	}
+}
+
+func TestSetPanicHandler(t *testing.T) {
+	var msgs []string
+	var errs []error
+	SetPanicHandler(func(msg string, err error) {
+		msgs = append(msgs, msg)
+		errs = append(errs, err)
+	})
+	defer SetPanicHandler(nil)
+
+	boom := errors.New("boom")
+	x := 1
+	if Err[int](boom).Unwrap() != nil || Ok(&x).UnwrapError() != nil || Err[int](boom).Expect("expected") != nil {
+		t.Error("failed methods did not return nil")
+	}
+	if PropagateErr[string](Ok(&x)).IsOk() {
+		t.Error("PropagateErr returned an Ok")
+	}
+	if option.None[int]().Unwrap("") != nil {
+		t.Error("Option.Unwrap did not return nil")
+	}
+	if len(msgs) != 5 || msgs[2] != "expected" || errs[0] != boom || errs[1] != nil || errs[4] != nil {
+		t.Errorf("handler calls: %q %v", msgs, errs)
+	}
+
+	SetPanicHandler(nil)
+	defer func() {
+		if recover() == nil {
+			t.Error("removing the handler did not restore the panics")
+		}
+	}()
+	option.None[int]().Unwrap("")
+}
+
+func TestUnwrapChecked(t *testing.T) {
+	x := 1
+	if v, err := Ok(&x).UnwrapChecked(); *v != 1 || err != nil {
+		t.Error("UnwrapChecked failed on Ok")
+	}
+	boom := errors.New("boom")
+	if v, err := Err[int](boom).UnwrapChecked(); v != nil || err != boom {
+		t.Error("UnwrapChecked failed on Err")
+	}
+}
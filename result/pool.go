@@ -0,0 +1,50 @@
+package result
+
+import (
+	"reflect"
+	"sync"
+)
+
+// pools holds one `*sync.Pool` of `*Result[T]` per type `T`.
+var pools sync.Map
+
+func poolOf[T any]() *sync.Pool {
+	t := reflect.TypeFor[T]()
+	if p, ok := pools.Load(t); ok {
+		return p.(*sync.Pool)
+	}
+	p, _ := pools.LoadOrStore(t, &sync.Pool{
+		New: func() any {
+			return new(Result[T])
+		},
+	})
+	return p.(*sync.Pool)
+}
+
+// OkPooled is like [`Ok`], but takes the result from a pool.
+// The result should be given back with `Release` once it is no longer used.
+func OkPooled[T any](v *T) *Result[T] {
+	r := poolOf[T]().Get().(*Result[T])
+	r.value = v
+	return created(r)
+}
+
+// ErrPooled is like [`Err`], but takes the result from a pool.
+// The result should be given back with `Release` once it is no longer used.
+func ErrPooled[T any](err error) *Result[T] {
+	if err != nil {
+		fireErr(err, Created)
+	}
+	r := poolOf[T]().Get().(*Result[T])
+	r.err = err
+	return created(r)
+}
+
+// Release resets the result and puts it back to the pool.
+// The result must not be used after calling Release.
+func (r *Result[T]) Release() {
+	r.value = nil
+	r.err = nil
+	r.trace = nil
+	poolOf[T]().Put(r)
+}
@@ -0,0 +1,28 @@
+package result
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPooled(t *testing.T) {
+	x := 1
+	r := OkPooled(&x)
+	if *r.Unwrap() != 1 {
+		t.Error("OkPooled failed")
+	}
+	r.Release()
+
+	e := ErrPooled[int](errors.New("boom"))
+	if !e.IsErr() || e.UnwrapOrDefault() != nil {
+		t.Error("ErrPooled failed")
+	}
+	e.Release()
+}
+
+func BenchmarkOkPooled(b *testing.B) {
+	x := 1
+	for i := 0; i < b.N; i++ {
+		OkPooled(&x).Release()
+	}
+}
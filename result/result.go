@@ -1,39 +1,61 @@
 package result
 
+import (
+	"errors"
+	"reflect"
+)
+
 type Result[T any] struct {
 	value *T
 	err   error
+	trace *traceStep
 }
 
 func New[T any](v *T, e error) *Result[T] {
 	if e == nil {
-		return &Result[T]{value: v}
+		return created(&Result[T]{value: v})
 	}
-	return &Result[T]{err: e}
+	fireErr(e, Created)
+	return created(&Result[T]{err: e})
 }
 
 func Ok[T any](v *T) *Result[T] {
-	return &Result[T]{value: v}
+	return created(&Result[T]{value: v})
 }
 
 func Err[T any](err error) *Result[T] {
-	return &Result[T]{err: err}
+	if err != nil {
+		fireErr(err, Created)
+	}
+	return created(&Result[T]{err: err})
 }
 
 // And returns `out` if the result is [`Ok`], otherwise returns the [`Err`] value of `in`.
 func And[T any, U any](in *Result[T], out *Result[U]) *Result[U] {
 	if in.IsErr() {
-		return Err[U](in.err)
+		return step(in.trace, in.err, created(&Result[U]{err: in.err}), "And")
+	}
+	return step(in.trace, nil, out, "And")
+}
+
+// PropagateErr converts the [`Err`] `in` to an [`Err`] of another type, keeping its error and trace.
+// Only the target type needs to be spelled out: `return result.PropagateErr[User](r)`.
+// Panics if `in` is [`Ok`].
+func PropagateErr[U any, T any](in *Result[T]) *Result[U] {
+	if in.IsOk() {
+		msg := "called `PropagateErr` on an `Ok` value"
+		fail(msg, nil)
+		return created(&Result[U]{err: errors.New(msg)})
 	}
-	return out
+	return step(in.trace, in.err, created(&Result[U]{err: in.err}), "PropagateErr")
 }
 
 // AndThen calls `op` if the result is [`Ok`], otherwise returns the [`Err`] value of `in`.
 func AndThen[T any, U any](in *Result[T], op func(*T) *Result[U]) *Result[U] {
 	if in.IsErr() {
-		return Err[U](in.err)
+		return step(in.trace, in.err, created(&Result[U]{err: in.err}), "AndThen")
 	}
-	return op(in.value)
+	return step(in.trace, nil, op(in.value), "AndThen")
 }
 
 // Maps a `Result[T]` to `Result[U]` by applying a function to a
@@ -41,10 +63,12 @@ func AndThen[T any, U any](in *Result[T], op func(*T) *Result[U]) *Result[U] {
 //
 // This function can be used to compose the results of two functions.
 func Map[T any, U any](r Result[T], f func(*T) *U) *Result[U] {
-	if r.IsErr() {
-		return Err[U](r.err)
+	// `r` is a copy, checking it directly keeps it from escaping to the heap.
+	consumedCopy(r)
+	if r.err != nil {
+		return step(r.trace, r.err, created(&Result[U]{err: r.err}), "Map")
 	}
-	return Ok(f(r.value))
+	return step(r.trace, nil, Ok(f(r.value)), "Map")
 }
 
 // MapOr returns the provided fallback (if [`Err`]), or
@@ -67,19 +91,64 @@ func MapOrElse[T any, U any](r *Result[T], fallbackFn func(error) *U, f func(*T)
 	return f(r.value)
 }
 
+// MapV is like [`Map`] for a function taking and returning values.
+// A nil [`Ok`] value is passed as the zero value of `T`.
+func MapV[T any, U any](r *Result[T], f func(T) U) *Result[U] {
+	if r.IsErr() {
+		return step(r.trace, r.err, created(&Result[U]{err: r.err}), "MapV")
+	}
+	u := f(deref(r.value))
+	return step(r.trace, nil, Ok(&u), "MapV")
+}
+
+// MapOrV is like [`MapOr`] for a function taking and returning values.
+// A nil [`Ok`] value is passed as the zero value of `T`.
+func MapOrV[T any, U any](r *Result[T], fallback U, f func(T) U) U {
+	if r.IsErr() {
+		return fallback
+	}
+	return f(deref(r.value))
+}
+
+// deref returns the value `v` points to, or the zero value of `T` if `v` is nil.
+func deref[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return *v
+}
+
 // MapErr maps the error of a `Result[T]` to another error by applying a function to
 // a contained [`Err`] value, leaving an [`Ok`] value untouched.
 //
 // This function can be used to pass through a successful result while handling an error.
 func MapErr[T any](r *Result[T], f func(error) error) *Result[T] {
 	if r.IsOk() {
-		return r
+		return step(r.trace, nil, r, "MapErr")
+	}
+	return step(r.trace, r.err, created(&Result[T]{err: f(r.err)}), "MapErr")
+}
+
+// MapSame is like [`Map`] for a function that keeps the type, so that it can be chained as a method.
+func (r *Result[T]) MapSame(f func(*T) *T) *Result[T] {
+	if r.IsErr() {
+		return step(r.trace, r.err, r, "MapSame")
+	}
+	return step(r.trace, nil, Ok(f(r.value)), "MapSame")
+}
+
+// AndThenSame is like [`AndThen`] for a function that keeps the type, so that it can be chained as a method.
+func (r *Result[T]) AndThenSame(op func(*T) *Result[T]) *Result[T] {
+	if r.IsErr() {
+		return step(r.trace, r.err, r, "AndThenSame")
 	}
-	return Err[T](f(r.err))
+	return step(r.trace, nil, op(r.value), "AndThenSame")
 }
 
 // IsOk returns `true` if the result is [`Ok`].
 func (r Result[T]) IsOk() bool {
+	consumedCopy(r)
 	return r.err == nil
 }
 
@@ -98,8 +167,17 @@ func (r *Result[T]) IsOkAndNotNil() bool {
 	})
 }
 
+// IsOkAndNotZero returns `true` if the result is [`Ok`] and the value inside of it is neither nil
+// nor the zero value of `T`, as reported by `reflect.Value.IsZero`.
+func (r *Result[T]) IsOkAndNotZero() bool {
+	return r.IsOkAnd(func(t *T) bool {
+		return t != nil && !reflect.ValueOf(t).Elem().IsZero()
+	})
+}
+
 // IsErr returns `true` if the result is [`Err`].
 func (r *Result[T]) IsErr() bool {
+	consumed(r)
 	return r.err != nil
 }
 
@@ -122,6 +200,7 @@ func (r *Result[T]) Inspect(f func(*T)) *Result[T] {
 // InspectErr calls the provided closure with a reference to the contained error (if [`Err`]).
 func (r *Result[T]) InspectErr(f func(error)) *Result[T] {
 	if r.IsErr() {
+		fireErr(r.err, Inspected)
 		f(r.err)
 	}
 	return r
@@ -131,7 +210,7 @@ func (r *Result[T]) InspectErr(f func(error)) *Result[T] {
 // Panics if the value is an [`Err`], with a panic message including the passed message.
 func (r *Result[T]) Expect(msg string) *T {
 	if r.IsErr() {
-		panic(msg)
+		fail(msg, r.err)
 	}
 	return r.value
 }
@@ -140,15 +219,16 @@ func (r *Result[T]) Expect(msg string) *T {
 // Panics if the value is an [`Ok`], with a panic message including the passed message.
 func (r *Result[T]) ExpectErr(msg string) error {
 	if r.IsOk() {
-		panic(msg)
+		fail(msg, nil)
 	}
 	return r.err
 }
 
-// Unwrap extracts the value from the Result. Panics if the Result is Error.
+// Unwrap extracts the value from the Result. Panics if the Result is Error, see SetPanicHandler.
 func (r *Result[T]) Unwrap() *T {
 	if r.IsErr() {
-		panic("called `Result::unwrap()` on an `Err` value")
+		fail("called `Result::unwrap()` on an `Err` value", r.err)
+		return nil
 	}
 
 	return r.value
@@ -157,8 +237,10 @@ func (r *Result[T]) Unwrap() *T {
 // UnwrapError extracts the error from the Result. Panics if the Result is Ok.
 func (r *Result[T]) UnwrapError() error {
 	if r.IsOk() {
-		panic("called `Result::unwrap_err()` on an `Ok` value")
+		fail("called `Result::unwrap_err()` on an `Ok` value", nil)
+		return nil
 	}
+	fireErr(r.err, Inspected)
 
 	return r.err
 }
@@ -166,6 +248,7 @@ func (r *Result[T]) UnwrapError() error {
 // UnwrapOr extracts the value from the Result. Returns the provided value if the Result is Error.
 func (r *Result[T]) UnwrapOr(v *T) *T {
 	if r.IsErr() {
+		fireErr(r.err, Inspected)
 		return v
 	}
 
@@ -175,6 +258,7 @@ func (r *Result[T]) UnwrapOr(v *T) *T {
 // UnwrapOrElse returns the contained [`Ok`] value or computes it from a closure.
 func (r *Result[T]) UnwrapOrElse(f func() *T) *T {
 	if r.IsErr() {
+		fireErr(r.err, Inspected)
 		return f()
 	}
 	return r.value
@@ -183,6 +267,7 @@ func (r *Result[T]) UnwrapOrElse(f func() *T) *T {
 // UnwrapOrDefault returns the contained [`Ok`] value or a default (nil).
 func (r *Result[T]) UnwrapOrDefault() *T {
 	if r.IsErr() {
+		fireErr(r.err, Inspected)
 		return nil
 	}
 	return r.value
@@ -191,15 +276,15 @@ func (r *Result[T]) UnwrapOrDefault() *T {
 // Or returns `res` if the result is [`Err`], otherwise returns the [`Ok`] value of `self`.
 func (r *Result[T]) Or(res *Result[T]) *Result[T] {
 	if r.IsOk() {
-		return r
+		return step(r.trace, nil, r, "Or")
 	}
-	return res
+	return step(r.trace, r.err, res, "Or")
 }
 
 // OrElse calls `op` if the result is [`Err`], otherwise returns the [`Ok`] value of `self`.
 func (r *Result[T]) OrElse(op func(error) *Result[T]) *Result[T] {
 	if r.IsOk() {
-		return r
+		return step(r.trace, nil, r, "OrElse")
 	}
-	return op(r.err)
+	return step(r.trace, r.err, op(r.err), "OrElse")
 }
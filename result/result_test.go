@@ -1,6 +1,9 @@
 package result
 
-import "testing"
+import (
+	"errors"
+	"testing"
+)
 
 func TestResult(t *testing.T) {
 	var x int = 12345
@@ -14,3 +17,67 @@ func TestResult(t *testing.T) {
 		t.Error("Unwrap failed")
 	}
 }
+
+func TestSame(t *testing.T) {
+	x := 1
+	double := func(v *int) *int { n := *v * 2; return &n }
+	half := func(v *int) *Result[int] {
+		if *v%2 != 0 {
+			return Err[int](errors.New("odd"))
+		}
+		n := *v / 2
+		return Ok(&n)
+	}
+	if *Ok(&x).MapSame(double).MapSame(double).AndThenSame(half).Unwrap() != 2 {
+		t.Error("MapSame/AndThenSame failed")
+	}
+	if Ok(&x).AndThenSame(half).MapSame(double).IsOk() {
+		t.Error("Err was not propagated")
+	}
+}
+
+func TestMapV(t *testing.T) {
+	x := 2
+	square := func(v int) int { return v * v }
+	if *MapV(Ok(&x), square).Unwrap() != 4 || *MapV(Ok[int](nil), square).Unwrap() != 0 {
+		t.Error("MapV failed")
+	}
+	boom := errors.New("boom")
+	if !errors.Is(MapV(Err[int](boom), square).UnwrapError(), boom) {
+		t.Error("MapV did not keep the Err")
+	}
+	if MapOrV(Ok(&x), -1, square) != 4 || MapOrV(Err[int](boom), -1, square) != -1 {
+		t.Error("MapOrV failed")
+	}
+}
+
+func TestIsOkAndNotZero(t *testing.T) {
+	zero, one := 0, 1
+	var empty any
+	if !Ok(&one).IsOkAndNotZero() || Ok(&zero).IsOkAndNotZero() || Ok[int](nil).IsOkAndNotZero() {
+		t.Error("IsOkAndNotZero failed")
+	}
+	if Ok(&empty).IsOkAndNotZero() || Err[int](errors.New("boom")).IsOkAndNotZero() {
+		t.Error("IsOkAndNotZero failed on nil interface or Err")
+	}
+}
+
+func TestPropagateErr(t *testing.T) {
+	boom := errors.New("boom")
+	r := AndThen(Ok(new(int)), func(*int) *Result[string] {
+		in := Err[int](boom)
+		if in.IsErr() {
+			return PropagateErr[string](in)
+		}
+		return Ok(new(string))
+	})
+	if !errors.Is(r.UnwrapError(), boom) {
+		t.Error("PropagateErr failed")
+	}
+	defer func() {
+		if recover() == nil {
+			t.Error("PropagateErr did not panic on Ok")
+		}
+	}()
+	PropagateErr[string](Ok(new(int)))
+}
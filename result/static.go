@@ -0,0 +1,47 @@
+package result
+
+import (
+	"reflect"
+	"sync"
+)
+
+// statics holds, for every sentinel error registered with RegisterStatic,
+// a `*sync.Map` of one shared `*Result[T]` per type `T`.
+var statics sync.Map
+
+// RegisterStatic registers sentinel errors for ErrStatic. The errors must be comparable,
+// typically package-level variables created by errors.New.
+func RegisterStatic(errs ...error) {
+	for _, err := range errs {
+		if err == nil || !reflect.TypeOf(err).Comparable() {
+			panic("result: RegisterStatic needs non-nil comparable errors")
+		}
+		statics.LoadOrStore(err, new(sync.Map))
+	}
+}
+
+// ErrStatic is like [`Err`], but returns a shared result for a sentinel registered with RegisterStatic,
+// so returning it doesn't allocate. The shared result must not be modified, e.g. by Release or
+// by unmarshaling into it, and it isn't reported to the Tracker since all the callers share it.
+// Any other error gets a new result, as with [`Err`].
+func ErrStatic[T any](sentinel error) *Result[T] {
+	if sentinel != nil {
+		fireErr(sentinel, Created)
+	}
+	if sentinel == nil || !reflect.TypeOf(sentinel).Comparable() {
+		return created(&Result[T]{err: sentinel})
+	}
+	byType, ok := statics.Load(sentinel)
+	if !ok {
+		return created(&Result[T]{err: sentinel})
+	}
+	t := reflect.TypeFor[T]()
+	r, ok := byType.(*sync.Map).Load(t)
+	if !ok {
+		r, _ = byType.(*sync.Map).LoadOrStore(t, &Result[T]{err: sentinel})
+	}
+	if counting.Load() {
+		count(sentinel)
+	}
+	return r.(*Result[T])
+}
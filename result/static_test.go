@@ -0,0 +1,47 @@
+package result
+
+import (
+	"errors"
+	"testing"
+)
+
+var errStaticTest = errors.New("static")
+
+func TestErrStatic(t *testing.T) {
+	RegisterStatic(errStaticTest)
+	a, b := ErrStatic[int](errStaticTest), ErrStatic[int](errStaticTest)
+	if a != b || !errors.Is(a.UnwrapError(), errStaticTest) {
+		t.Error("ErrStatic did not share the result")
+	}
+	if s := ErrStatic[string](errStaticTest); !s.IsErr() || any(s) == any(a) {
+		t.Error("ErrStatic shared a result across types")
+	}
+
+	other := errors.New("other")
+	if ErrStatic[int](other) == ErrStatic[int](other) {
+		t.Error("ErrStatic shared a result of an unregistered error")
+	}
+}
+
+type countingTracker struct{ created int }
+
+func (c *countingTracker) Created(any)  { c.created++ }
+func (c *countingTracker) Consumed(any) {}
+
+func TestErrStaticHooks(t *testing.T) {
+	RegisterStatic(errStaticTest)
+	fired := 0
+	SetErrHook(func(error, Meta) { fired++ })
+	defer SetErrHook(nil)
+	if !ErrStatic[int](nil).IsOk() || fired != 0 {
+		t.Error("ErrStatic(nil) called the hook")
+	}
+
+	var c countingTracker
+	SetTracker(&c)
+	defer SetTracker(nil)
+	ErrStatic[int](errStaticTest)
+	if c.created != 0 || fired != 1 {
+		t.Errorf("ErrStatic tracked the shared result %d times", c.created)
+	}
+}
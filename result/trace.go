@@ -0,0 +1,81 @@
+package result
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"sync/atomic"
+)
+
+// Step is a combinator, or a label, a traced result went through.
+type Step struct {
+	// Op is the combinator, e.g. `AndThen`, or the label given to Label.
+	Op string `json:"op"`
+	// Branch is the state of the input of the step, "ok" or "err".
+	Branch string `json:"branch"`
+	// File and Line are the location of the call.
+	File string `json:"file"`
+	Line int    `json:"line"`
+}
+
+func (s Step) String() string {
+	return fmt.Sprintf("%s %s (%s:%d)", s.Op, s.Branch, s.File, s.Line)
+}
+
+// Trace is the lineage of a result, oldest step first.
+type Trace []Step
+
+// String formats the trace one step per line.
+func (t Trace) String() string {
+	var b strings.Builder
+	for i, s := range t {
+		fmt.Fprintf(&b, "%d. %s\n", i+1, s)
+	}
+	return b.String()
+}
+
+// traceStep links a step to the steps before it, results of the same pipeline sharing their common steps.
+type traceStep struct {
+	Step
+	prev *traceStep
+}
+
+var tracing atomic.Bool
+
+// SetTracing enables or disables the tracing of the results going through the combinators.
+// Tracing costs an allocation and a stack lookup per step, it is meant for debugging.
+func SetTracing(enabled bool) {
+	tracing.Store(enabled)
+}
+
+// Trace returns the steps the result went through while tracing was enabled, nil if none.
+func (r *Result[T]) Trace() Trace {
+	var t Trace
+	for s := r.trace; s != nil; s = s.prev {
+		t = append(t, s.Step)
+	}
+	for i, j := 0, len(t)-1; i < j; i, j = i+1, j-1 {
+		t[i], t[j] = t[j], t[i]
+	}
+	return t
+}
+
+// Label records a step named `label` when tracing is enabled, e.g. to name a stage of a pipeline.
+func (r *Result[T]) Label(label string) *Result[T] {
+	return step(r.trace, r.err, r, label)
+}
+
+// step records the step `op` on the output of a combinator when tracing is enabled,
+// `prev` and `err` coming from its input. The output is copied as it may be shared.
+func step[U any](prev *traceStep, err error, out *Result[U], op string) *Result[U] {
+	if !tracing.Load() {
+		return out
+	}
+	s := &traceStep{Step: Step{Op: op, Branch: "ok"}, prev: prev}
+	if err != nil {
+		s.Branch = "err"
+	}
+	_, s.File, s.Line, _ = runtime.Caller(2)
+	consumed(out)
+	return created(&Result[U]{value: out.value, err: out.err, trace: s})
+}
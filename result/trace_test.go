@@ -0,0 +1,45 @@
+package result
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestTrace(t *testing.T) {
+	SetTracing(true)
+	defer SetTracing(false)
+
+	x := 1
+	r := AndThen(Ok(&x).Label("parse"), func(*int) *Result[int] {
+		return Err[int](errors.New("boom"))
+	}).OrElse(func(error) *Result[int] {
+		return Ok(&x)
+	})
+	r = Map(*r, func(v *int) *int { return v })
+
+	trace := r.Trace()
+	var ops []string
+	for _, s := range trace {
+		ops = append(ops, s.Op+" "+s.Branch)
+	}
+	if got := strings.Join(ops, ", "); got != "parse ok, AndThen ok, OrElse err, Map ok" {
+		t.Errorf("Trace failed: %s", got)
+	}
+	if !strings.HasSuffix(trace[0].File, "trace_test.go") || trace[0].Line == 0 {
+		t.Errorf("Trace failed to locate the step: %s", trace[0])
+	}
+	if !strings.HasPrefix(trace.String(), "1. parse ok (") {
+		t.Errorf("Trace.String failed: %s", trace)
+	}
+	b, err := json.Marshal(trace[1:2])
+	if err != nil || !strings.HasPrefix(string(b), `[{"op":"AndThen","branch":"ok","file":`) {
+		t.Errorf("Trace JSON failed: %s, %v", b, err)
+	}
+
+	SetTracing(false)
+	if Map(*Ok(&x), func(v *int) *int { return v }).Trace() != nil {
+		t.Error("Trace recorded while disabled")
+	}
+}
@@ -0,0 +1,68 @@
+package result
+
+import (
+	"sync/atomic"
+
+	"github.com/yuanzicheng/go-result-and-option/internal/peek"
+)
+
+// Tracker is notified of the creation and the consumption of results,
+// see package resultdebug.
+type Tracker interface {
+	// Created is called with every new result.
+	Created(r any)
+	// Consumed is called every time a result is checked or unwrapped.
+	Consumed(r any)
+}
+
+// CopyTracker is optionally implemented by a Tracker to be notified of the consumption of a copy
+// of a result, by the APIs taking results by value such as Map and IsOk. The copy is passed by
+// value, so a tracker keyed by pointers has to match it with a tracked result by content.
+type CopyTracker interface {
+	ConsumedCopy(r any)
+}
+
+var tracker atomic.Pointer[Tracker]
+
+// SetTracker sets the tracker of results, replacing any previous one. A nil tracker removes it.
+func SetTracker(t Tracker) {
+	if t == nil {
+		tracker.Store(nil)
+		return
+	}
+	tracker.Store(&t)
+}
+
+func created[T any](r *Result[T]) *Result[T] {
+	if counting.Load() {
+		count(r.err)
+	}
+	if t := tracker.Load(); t != nil {
+		(*t).Created(r)
+	}
+	return r
+}
+
+func consumed[T any](r *Result[T]) {
+	if t := tracker.Load(); t != nil {
+		(*t).Consumed(r)
+	}
+}
+
+func consumedCopy[T any](r Result[T]) {
+	if t := tracker.Load(); t != nil {
+		if c, ok := (*t).(CopyTracker); ok {
+			c.ConsumedCopy(r)
+		}
+	}
+}
+
+func init() {
+	peek.Result = func(r any) (any, error) {
+		return r.(interface{ peek() (any, error) }).peek()
+	}
+}
+
+func (r *Result[T]) peek() (any, error) {
+	return r.value, r.err
+}
@@ -0,0 +1,34 @@
+package result
+
+import (
+	"fmt"
+
+	"github.com/yuanzicheng/go-result-and-option/errorsx"
+)
+
+// WrapErr wraps the error of an [`Err`] with the message and the location of the caller,
+// see errorsx.Wrap. An [`Ok`] is returned unchanged.
+func WrapErr[T any](r *Result[T], msg string) *Result[T] {
+	if r.IsOk() {
+		return r
+	}
+	return Err[T](errorsx.WrapDepth(1, r.err, msg))
+}
+
+// Wrap wraps the error of an [`Err`] with a message formatted by fmt.Sprintf, like `fmt.Errorf("msg: %w", err)`.
+// When tracing is enabled, see SetTracing, the location of the caller is recorded as well, as WrapErr does.
+// An [`Ok`] is returned unchanged.
+func Wrap[T any](r *Result[T], format string, args ...any) *Result[T] {
+	if r.IsOk() {
+		return r
+	}
+	msg := fmt.Sprintf(format, args...)
+	var err error
+	if tracing.Load() {
+		err = errorsx.WrapDepth(1, r.err, msg)
+	} else {
+		err = fmt.Errorf("%s: %w", msg, r.err)
+	}
+	fireErr(err, Created)
+	return step(r.trace, r.err, created(&Result[T]{err: err}), "Wrap")
+}
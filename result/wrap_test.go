@@ -0,0 +1,40 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"testing"
+)
+
+func TestWrapErr(t *testing.T) {
+	x := 1
+	if r := Ok(&x); WrapErr(r, "reading") != r {
+		t.Error("WrapErr failed on Ok")
+	}
+	err := WrapErr(Err[int](io.EOF), "reading").UnwrapError()
+	if s := fmt.Sprintf("%+v", err); s != "reading (wrap_test.go:15): EOF" {
+		t.Errorf("WrapErr failed: %s", s)
+	}
+}
+
+func TestWrap(t *testing.T) {
+	x := 1
+	if r := Ok(&x); Wrap(r, "loading user %d", 7) != r {
+		t.Error("Wrap failed on Ok")
+	}
+	err := Wrap(Err[int](io.EOF), "loading user %d", 7).UnwrapError()
+	if err.Error() != "loading user 7: EOF" || !errors.Is(err, io.EOF) {
+		t.Errorf("Wrap failed: %v", err)
+	}
+	if s := fmt.Sprintf("%+v", err); s != "loading user 7: EOF" {
+		t.Errorf("Wrap recorded a location without tracing: %s", s)
+	}
+
+	SetTracing(true)
+	defer SetTracing(false)
+	err = Wrap(Err[int](io.EOF), "loading user %d", 7).UnwrapError()
+	if s := fmt.Sprintf("%+v", err); s != "loading user 7 (wrap_test.go:36): EOF" {
+		t.Errorf("Wrap did not record the location: %s", s)
+	}
+}
@@ -0,0 +1,39 @@
+package result
+
+import "errors"
+
+// MarshalYAML encodes the result as the mapping `ok: value` for an [`Ok`], or `err: message`
+// for an [`Err`]. It implements the `Marshaler` interface of gopkg.in/yaml.v2 and v3.
+func (r Result[T]) MarshalYAML() (any, error) {
+	if r.err != nil {
+		return map[string]string{"err": r.err.Error()}, nil
+	}
+	return map[string]*T{"ok": r.value}, nil
+}
+
+// UnmarshalYAML decodes a mapping encoded by MarshalYAML, the error of an [`Err`] being
+// decoded as an error with the same message. It implements the `Unmarshaler` interface
+// of gopkg.in/yaml.v2, still supported by v3.
+func (r *Result[T]) UnmarshalYAML(unmarshal func(any) error) error {
+	var m struct {
+		Ok  *T      `yaml:"ok" json:"ok"`
+		Err *string `yaml:"err" json:"err"`
+	}
+	var keys map[string]any
+	if err := unmarshal(&keys); err != nil {
+		return err
+	}
+	if err := unmarshal(&m); err != nil {
+		return err
+	}
+	_, ok := keys["ok"]
+	switch {
+	case m.Err != nil && !ok:
+		r.value, r.err = nil, errors.New(*m.Err)
+	case ok && m.Err == nil:
+		r.value, r.err = m.Ok, nil
+	default:
+		return errors.New("result: YAML mapping must have exactly one of ok and err")
+	}
+	return nil
+}
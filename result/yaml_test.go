@@ -0,0 +1,44 @@
+package result
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+// unmarshalJSON stands for the unmarshal function of a YAML decoder, JSON being a subset of YAML.
+func unmarshalJSON(s string) func(any) error {
+	return func(v any) error {
+		return json.Unmarshal([]byte(s), v)
+	}
+}
+
+func TestMarshalYAML(t *testing.T) {
+	x := 1
+	v, err := Ok(&x).MarshalYAML()
+	if err != nil || *v.(map[string]*int)["ok"] != 1 {
+		t.Error("MarshalYAML failed on Ok")
+	}
+	v, err = Err[int](errors.New("boom")).MarshalYAML()
+	if err != nil || v.(map[string]string)["err"] != "boom" {
+		t.Error("MarshalYAML failed on Err")
+	}
+}
+
+func TestUnmarshalYAML(t *testing.T) {
+	var r Result[[]int]
+	if err := r.UnmarshalYAML(unmarshalJSON(`{"ok": [1, 2]}`)); err != nil || (*r.Unwrap())[1] != 2 {
+		t.Errorf("UnmarshalYAML failed on Ok: %v", err)
+	}
+	if err := r.UnmarshalYAML(unmarshalJSON(`{"err": "boom"}`)); err != nil || r.UnwrapError().Error() != "boom" {
+		t.Errorf("UnmarshalYAML failed on Err: %v", err)
+	}
+	if err := r.UnmarshalYAML(unmarshalJSON(`{"ok": null}`)); err != nil || r.Unwrap() != nil {
+		t.Errorf("UnmarshalYAML failed on a null Ok: %v", err)
+	}
+	for _, doc := range []string{`{}`, `{"ok": [1], "err": "boom"}`, `[1]`} {
+		if err := r.UnmarshalYAML(unmarshalJSON(doc)); err == nil {
+			t.Errorf("UnmarshalYAML failed to reject %s", doc)
+		}
+	}
+}
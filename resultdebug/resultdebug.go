@@ -0,0 +1,154 @@
+package resultdebug
+
+import (
+	"cmp"
+	"fmt"
+	"net/http"
+	"reflect"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// modulePrefix identifies the frames of the library, skipped when looking for creation sites.
+const modulePrefix = "github.com/yuanzicheng/go-result-and-option/"
+
+// Instance is a result or an option created but not consumed yet.
+type Instance struct {
+	// ID numbers the instances in creation order.
+	ID uint64
+	// Type is the type of the instance, e.g. `*result.Result[int]`.
+	Type string
+	// File, Line and Function are the location of the creation, outside of this library.
+	File     string
+	Line     int
+	Function string
+}
+
+func (i Instance) String() string {
+	return fmt.Sprintf("#%d %s created at %s:%d (%s)", i.ID, i.Type, i.File, i.Line, i.Function)
+}
+
+type tracker struct {
+	mu   sync.Mutex
+	next uint64
+	live map[any]Instance
+}
+
+func (t *tracker) Created(v any) {
+	inst := Instance{Type: fmt.Sprintf("%T", v)}
+	inst.File, inst.Line, inst.Function = site()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.next++
+	inst.ID = t.next
+	t.live[v] = inst
+}
+
+func (t *tracker) Consumed(v any) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.live, v)
+}
+
+// ConsumedCopy forgets the oldest tracked instance with the same content as the copy `v`,
+// as results taken by value can't be told apart from the other results sharing their content.
+func (t *tracker) ConsumedCopy(v any) {
+	cp := reflect.ValueOf(v)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var key any
+	var id uint64
+	for k, inst := range t.live {
+		kv := reflect.ValueOf(k)
+		if kv.Type().Elem() == cp.Type() && sameContent(kv.Elem(), cp) && (key == nil || inst.ID < id) {
+			key, id = k, inst.ID
+		}
+	}
+	if key != nil {
+		delete(t.live, key)
+	}
+}
+
+// sameContent compares the fields of `a` and `b` shallowly, values of uncomparable types being different.
+func sameContent(a, b reflect.Value) (same bool) {
+	defer func() {
+		if recover() != nil {
+			same = false
+		}
+	}()
+	return a.Equal(b)
+}
+
+// site returns the first caller outside of this library, tests of the library excepted.
+func site() (string, int, string) {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+	for {
+		f, more := frames.Next()
+		if !strings.HasPrefix(f.Function, modulePrefix) || strings.HasSuffix(f.File, "_test.go") {
+			return f.File, f.Line, f.Function
+		}
+		if !more {
+			return f.File, f.Line, f.Function
+		}
+	}
+}
+
+var (
+	mu      sync.Mutex
+	current *tracker
+)
+
+// Enable starts tracking the results and options created from now on, forgetting
+// the previous ones. Unconsumed instances are kept alive until they are consumed
+// or Disable is called, so the debug mode is meant for tests and debugging sessions.
+func Enable() {
+	mu.Lock()
+	defer mu.Unlock()
+	current = &tracker{live: make(map[any]Instance)}
+	result.SetTracker(current)
+	option.SetTracker(current)
+}
+
+// Disable stops tracking and forgets the tracked instances.
+func Disable() {
+	mu.Lock()
+	defer mu.Unlock()
+	result.SetTracker(nil)
+	option.SetTracker(nil)
+	current = nil
+}
+
+// Report returns the instances created since Enable and never checked or unwrapped,
+// in creation order. It returns nil if tracking is disabled.
+func Report() []Instance {
+	mu.Lock()
+	t := current
+	mu.Unlock()
+	if t == nil {
+		return nil
+	}
+	t.mu.Lock()
+	insts := make([]Instance, 0, len(t.live))
+	for _, inst := range t.live {
+		insts = append(insts, inst)
+	}
+	t.mu.Unlock()
+	slices.SortFunc(insts, func(a, b Instance) int { return cmp.Compare(a.ID, b.ID) })
+	return insts
+}
+
+// Handler returns an HTTP handler writing the report as plain text, one instance per line.
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		for _, inst := range Report() {
+			fmt.Fprintln(w, inst)
+		}
+	})
+}
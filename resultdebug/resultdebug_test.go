@@ -0,0 +1,65 @@
+package resultdebug
+
+import (
+	"errors"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+func TestReport(t *testing.T) {
+	Enable()
+	defer Disable()
+
+	x := 1
+	checked := result.Ok(&x)
+	result.Err[int](errors.New("swallowed"))
+	option.Some(&x).UnwrapOr(&x)
+	option.None[int]()
+	checked.IsOk()
+
+	insts := Report()
+	if len(insts) != 2 || insts[0].Type != "*result.Result[int]" || insts[1].Type != "*option.Option[int]" {
+		t.Fatalf("Report failed: %v", insts)
+	}
+	if filepath.Base(insts[0].File) != "resultdebug_test.go" || insts[0].Line != 20 || insts[0].ID > insts[1].ID {
+		t.Errorf("Report failed: %v", insts[0])
+	}
+
+	w := httptest.NewRecorder()
+	Handler().ServeHTTP(w, httptest.NewRequest("GET", "/debug/results", nil))
+	if lines := strings.Split(strings.TrimSpace(w.Body.String()), "\n"); len(lines) != 2 || !strings.HasPrefix(lines[0], "#2 *result.Result[int] created at ") {
+		t.Errorf("Handler failed: %s", w.Body.String())
+	}
+}
+
+func TestDisable(t *testing.T) {
+	Enable()
+	Disable()
+	result.Err[int](errors.New("untracked"))
+	if Report() != nil {
+		t.Error("Disable failed")
+	}
+}
+
+func TestReportCopies(t *testing.T) {
+	Enable()
+	defer Disable()
+
+	x, y := 1, 2
+	r := result.Ok(&x)
+	other := result.Ok(&y)
+	m := result.Map(*r, func(v *int) *int { return v })
+	m.IsErr()
+	if insts := Report(); len(insts) != 1 || insts[0].ID != 2 {
+		t.Errorf("Map of a copy did not consume the result: %v", insts)
+	}
+	other.IsOk()
+	if insts := Report(); len(insts) != 0 {
+		t.Errorf("IsOk did not consume the result: %v", insts)
+	}
+}
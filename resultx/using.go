@@ -0,0 +1,42 @@
+// Package resultx holds patterns built on top of results.
+package resultx
+
+import (
+	"errors"
+	"io"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// Using acquires a resource, passes it to `use` and closes it on every path, including a panic in `use`.
+// An [`Err`] from `acquire` is returned as is without calling `use`, and an [`Ok`] of nil
+// becomes an Invalid [`Err`].
+// An error from Close turns the result into an [`Err`], joined with the error of `use` if there is one.
+func Using[R io.Closer, T any](acquire func() *result.Result[R], use func(R) *result.Result[T]) *result.Result[T] {
+	acquired := acquire()
+	if acquired.IsErr() {
+		return result.Err[T](acquired.UnwrapError())
+	}
+	p := acquired.Unwrap()
+	if p == nil {
+		return result.Err[T](errkind.New(errkind.Invalid, "resultx: acquire returned no resource"))
+	}
+	res := *p
+
+	closed := false
+	defer func() {
+		if !closed {
+			res.Close()
+		}
+	}()
+	out := use(res)
+	closed = true
+	if err := res.Close(); err != nil {
+		if out.IsErr() {
+			return result.Err[T](errors.Join(out.UnwrapError(), err))
+		}
+		return result.Err[T](err)
+	}
+	return out
+}
@@ -0,0 +1,71 @@
+package resultx
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+type closer struct {
+	closed int
+	err    error
+}
+
+func (c *closer) Close() error {
+	c.closed++
+	return c.err
+}
+
+func TestUsing(t *testing.T) {
+	boom, closeErr := errors.New("boom"), errors.New("close")
+	acquire := func(c *closer) func() *result.Result[*closer] {
+		return func() *result.Result[*closer] { return result.Ok(&c) }
+	}
+	one := 1
+	ok := func(*closer) *result.Result[int] { return result.Ok(&one) }
+	fail := func(*closer) *result.Result[int] { return result.Err[int](boom) }
+
+	c := &closer{}
+	if *Using(acquire(c), ok).Unwrap() != 1 || c.closed != 1 {
+		t.Error("Ok path failed")
+	}
+	c = &closer{}
+	if !errors.Is(Using(acquire(c), fail).UnwrapError(), boom) || c.closed != 1 {
+		t.Error("Err path failed")
+	}
+	c = &closer{err: closeErr}
+	if !errors.Is(Using(acquire(c), ok).UnwrapError(), closeErr) {
+		t.Error("Close error was lost")
+	}
+	c = &closer{err: closeErr}
+	if err := Using(acquire(c), fail).UnwrapError(); !errors.Is(err, boom) || !errors.Is(err, closeErr) {
+		t.Error("errors were not joined")
+	}
+
+	called := false
+	r := Using(func() *result.Result[*closer] { return result.Err[*closer](boom) }, func(*closer) *result.Result[int] {
+		called = true
+		return nil
+	})
+	if called || !errors.Is(r.UnwrapError(), boom) {
+		t.Error("acquire Err failed")
+	}
+	r = Using(func() *result.Result[*closer] { return result.Ok[*closer](nil) }, func(*closer) *result.Result[int] {
+		called = true
+		return nil
+	})
+	if called || !errors.Is(r.UnwrapError(), errkind.Invalid) {
+		t.Error("acquire Ok of nil failed")
+	}
+
+	c = &closer{}
+	func() {
+		defer func() { recover() }()
+		Using(acquire(c), func(*closer) *result.Result[int] { panic("boom") })
+	}()
+	if c.closed != 1 {
+		t.Error("resource was not closed on panic")
+	}
+}
@@ -0,0 +1,58 @@
+package schedule
+
+import (
+	"context"
+	"time"
+
+	"github.com/yuanzicheng/go-result-and-option/future"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// After calls `f` once `d` has elapsed and returns a future of its result.
+// If the context is done first, the future completes with an [`Err`] of `ctx.Err()`.
+// A panic in `f` completes the future with an [`Err`] of `*result.PanicError`.
+func After[T any](ctx context.Context, d time.Duration, f func(context.Context) *result.Result[T]) *future.Future[T] {
+	fut, complete := future.New[T]()
+	go func() {
+		t := time.NewTimer(d)
+		defer t.Stop()
+		select {
+		case <-t.C:
+			complete(result.Catch(func() *result.Result[T] {
+				return f(ctx)
+			}))
+		case <-ctx.Done():
+			complete(result.Err[T](ctx.Err()))
+		}
+	}()
+	return fut
+}
+
+// Every calls `f` every `d` and sends its results to the returned channel,
+// converting panics into [`Err`] values of `*result.PanicError`.
+// The channel is closed once the context is done. A tick is skipped
+// while the previous result hasn't been received yet.
+func Every[T any](ctx context.Context, d time.Duration, f func(context.Context) *result.Result[T]) <-chan *result.Result[T] {
+	out := make(chan *result.Result[T])
+	go func() {
+		defer close(out)
+		t := time.NewTicker(d)
+		defer t.Stop()
+		for {
+			select {
+			case <-t.C:
+			case <-ctx.Done():
+				return
+			}
+			r := result.Catch(func() *result.Result[T] {
+				return f(ctx)
+			})
+			select {
+			case out <- r:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
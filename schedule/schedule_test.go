@@ -0,0 +1,47 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+func TestAfter(t *testing.T) {
+	x := 1
+	f := After(context.Background(), time.Millisecond, func(context.Context) *result.Result[int] {
+		return result.Ok(&x)
+	})
+	if *f.Await(context.Background()).Unwrap() != 1 {
+		t.Error("After failed")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	f = After(ctx, time.Hour, func(context.Context) *result.Result[int] {
+		return result.Ok(&x)
+	})
+	if !errors.Is(f.Await(context.Background()).UnwrapError(), context.Canceled) {
+		t.Error("After failed to cancel")
+	}
+}
+
+func TestEvery(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	n := 0
+	ch := Every(ctx, time.Millisecond, func(context.Context) *result.Result[int] {
+		n++
+		if n == 2 {
+			panic("boom")
+		}
+		return result.Ok(&n)
+	})
+	if !(<-ch).IsOk() || !(<-ch).IsErr() || !(<-ch).IsOk() {
+		t.Error("Every failed")
+	}
+	cancel()
+	for range ch {
+	}
+}
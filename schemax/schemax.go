@@ -0,0 +1,151 @@
+// Package schemax reflects Go types into JSON Schemas, as used by OpenAPI 3.1,
+// with [`Option`] fields marked as nullable and optional.
+package schemax
+
+import (
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/yuanzicheng/go-result-and-option/internal/fields"
+)
+
+// Schema is a JSON Schema.
+type Schema struct {
+	// Type is the JSON type, or the JSON types if nullable. Empty for any value.
+	Type                 []string           `json:"-"`
+	Format               string             `json:"format,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+}
+
+// MarshalJSON encodes a single type as a string and several types as an array.
+func (s *Schema) MarshalJSON() ([]byte, error) {
+	type schema Schema
+	var typ any
+	switch len(s.Type) {
+	case 0:
+	case 1:
+		typ = s.Type[0]
+	default:
+		typ = s.Type
+	}
+	return json.Marshal(struct {
+		Type any `json:"type,omitempty"`
+		*schema
+	}{typ, (*schema)(s)})
+}
+
+// Nullable reports whether the schema allows null.
+func (s *Schema) Nullable() bool {
+	return slices.Contains(s.Type, "null")
+}
+
+var (
+	timeType          = reflect.TypeFor[time.Time]()
+	jsonMarshalerType = reflect.TypeFor[json.Marshaler]()
+	textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+	bytesType         = reflect.TypeFor[[]byte]()
+	durationType      = reflect.TypeFor[time.Duration]()
+)
+
+// For returns the schema of `T`, see Of.
+func For[T any]() *Schema {
+	return Of(reflect.TypeFor[T]())
+}
+
+// Of returns the schema of a type, following encoding/json:
+//   - struct fields are properties named after their `json` tags, embedded structs being flattened;
+//   - [`Option`] fields are nullable and optional, their absence and null both standing for [`None`];
+//   - pointer fields are nullable, fields tagged `omitempty` or `omitzero` are optional;
+//   - `time.Time` is a date-time string, `[]byte` a base64 string and `encoding.TextMarshaler` types strings;
+//   - `json.Marshaler` types, interfaces and recursive types accept any value.
+func Of(t reflect.Type) *Schema {
+	return (&reflector{seen: map[reflect.Type]bool{}}).schema(t)
+}
+
+type reflector struct {
+	seen map[reflect.Type]bool
+}
+
+func (r *reflector) schema(t reflect.Type) *Schema {
+	switch {
+	case fields.IsOption(t):
+		return nullable(r.schema(fields.OptionElem(t)))
+	case t == timeType:
+		return &Schema{Type: []string{"string"}, Format: "date-time"}
+	case t == durationType:
+		return &Schema{Type: []string{"integer"}}
+	case t.Implements(jsonMarshalerType) || reflect.PointerTo(t).Implements(jsonMarshalerType):
+		return &Schema{}
+	case t.Implements(textMarshalerType) || reflect.PointerTo(t).Implements(textMarshalerType):
+		return &Schema{Type: []string{"string"}}
+	case t == bytesType:
+		return &Schema{Type: []string{"string"}, Format: "byte"}
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return &Schema{Type: []string{"boolean"}}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return &Schema{Type: []string{"integer"}}
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: []string{"number"}}
+	case reflect.String:
+		return &Schema{Type: []string{"string"}}
+	case reflect.Pointer:
+		return nullable(r.schema(t.Elem()))
+	case reflect.Slice:
+		return nullable(&Schema{Type: []string{"array"}, Items: r.schema(t.Elem())})
+	case reflect.Array:
+		return &Schema{Type: []string{"array"}, Items: r.schema(t.Elem())}
+	case reflect.Map:
+		return nullable(&Schema{Type: []string{"object"}, AdditionalProperties: r.schema(t.Elem())})
+	case reflect.Struct:
+		if r.seen[t] {
+			return &Schema{}
+		}
+		r.seen[t] = true
+		defer delete(r.seen, t)
+		s := &Schema{Type: []string{"object"}, Properties: map[string]*Schema{}}
+		r.properties(s, t)
+		return s
+	}
+	return &Schema{}
+}
+
+// properties adds the properties of the fields of the struct type `t` to the schema.
+func (r *reflector) properties(s *Schema, t reflect.Type) {
+	for i := range t.NumField() {
+		f := t.Field(i)
+		tag, opts, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if f.Anonymous && tag == "" && f.Type.Kind() == reflect.Struct && !fields.IsOption(f.Type) {
+			r.properties(s, f.Type)
+			continue
+		}
+		name := fields.Name(f, "json")
+		if name == "" {
+			continue
+		}
+		s.Properties[name] = r.schema(f.Type)
+		optional := fields.IsOption(f.Type) || slices.ContainsFunc(strings.Split(opts, ","), func(o string) bool {
+			return o == "omitempty" || o == "omitzero"
+		})
+		if !optional {
+			s.Required = append(s.Required, name)
+		}
+	}
+}
+
+// nullable adds null to the types of the schema, if not already.
+func nullable(s *Schema) *Schema {
+	if len(s.Type) > 0 && !s.Nullable() {
+		s.Type = append(s.Type, "null")
+	}
+	return s
+}
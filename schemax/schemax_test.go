@@ -0,0 +1,52 @@
+package schemax
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+)
+
+type audit struct {
+	Created time.Time `json:"created"`
+}
+
+type user struct {
+	audit
+	Name     string                `json:"name"`
+	Nickname option.Option[string] `json:"nickname"`
+	Age      *int                  `json:"age,omitempty"`
+	Tags     []string              `json:"tags,omitzero"`
+	Friends  []user                `json:"friends,omitempty"`
+	Ignored  string                `json:"-"`
+}
+
+func TestOf(t *testing.T) {
+	s := For[user]()
+	if !reflect.DeepEqual(s.Required, []string{"created", "name"}) {
+		t.Errorf("Of failed to require fields: %v", s.Required)
+	}
+	if n := s.Properties["nickname"]; !reflect.DeepEqual(n.Type, []string{"string", "null"}) {
+		t.Errorf("Of failed on an option: %v", n.Type)
+	}
+	if !s.Properties["age"].Nullable() || s.Properties["name"].Nullable() {
+		t.Error("Of failed on nullability")
+	}
+	if s.Properties["created"].Format != "date-time" || s.Properties["Ignored"] != nil {
+		t.Error("Of failed on fields")
+	}
+	if friends := s.Properties["friends"].Items; friends.Type != nil {
+		t.Error("Of failed on a recursive type")
+	}
+
+	b, err := json.Marshal(s.Properties["nickname"])
+	if err != nil || string(b) != `{"type":["string","null"]}` {
+		t.Errorf("MarshalJSON failed: %s", b)
+	}
+	b, _ = json.Marshal(For[option.Option[[]int]]().Items)
+	if string(b) != `{"type":"integer"}` {
+		t.Errorf("MarshalJSON failed: %s", b)
+	}
+}
@@ -0,0 +1,78 @@
+package singleflightx
+
+import (
+	"runtime/debug"
+	"sync"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+type call[T any] struct {
+	wg  sync.WaitGroup
+	res *result.Result[T]
+}
+
+// Group deduplicates concurrent calls sharing the same key.
+// The zero value is ready for use.
+type Group[T any] struct {
+	// Clone, if set, is applied to the [`Ok`] value handed to every caller
+	// that joined an in-flight call, so they don't share a pointer payload.
+	Clone func(*T) *T
+
+	mu sync.Mutex
+	m  map[string]*call[T]
+}
+
+// Do calls `f` and returns its result, making sure that only one call
+// for a given key is in-flight at a time. Duplicate callers wait for the
+// original call to complete and receive the same result.
+// If `f` panics, the panic is propagated to the original caller, and the
+// duplicate callers receive an [`Err`] of `*result.PanicError`.
+func (g *Group[T]) Do(key string, f func() *result.Result[T]) *result.Result[T] {
+	g.mu.Lock()
+	if g.m == nil {
+		g.m = make(map[string]*call[T])
+	}
+	if c, ok := g.m[key]; ok {
+		g.mu.Unlock()
+		c.wg.Wait()
+		return g.share(c.res)
+	}
+	c := new(call[T])
+	c.wg.Add(1)
+	g.m[key] = c
+	g.mu.Unlock()
+
+	defer func() {
+		v := recover()
+		if v != nil {
+			c.res = result.Err[T](&result.PanicError{Value: v, Stack: debug.Stack()})
+		}
+		g.mu.Lock()
+		if g.m[key] == c {
+			delete(g.m, key)
+		}
+		g.mu.Unlock()
+		c.wg.Done()
+		if v != nil {
+			panic(v)
+		}
+	}()
+	c.res = f()
+	return c.res
+}
+
+// Forget tells the group to forget about a key, so the next call to Do
+// for that key calls `f` rather than waiting for an earlier call to complete.
+func (g *Group[T]) Forget(key string) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	delete(g.m, key)
+}
+
+func (g *Group[T]) share(r *result.Result[T]) *result.Result[T] {
+	if g.Clone == nil || r.IsErr() {
+		return r
+	}
+	return result.Ok(g.Clone(r.Unwrap()))
+}
@@ -0,0 +1,114 @@
+package singleflightx
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+func TestDo(t *testing.T) {
+	g := Group[int]{
+		Clone: func(v *int) *int {
+			x := *v
+			return &x
+		},
+	}
+
+	var calls atomic.Int32
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r := g.Do("key", func() *result.Result[int] {
+				calls.Add(1)
+				time.Sleep(10 * time.Millisecond)
+				x := 1
+				return result.Ok(&x)
+			})
+			if *r.Unwrap() != 1 {
+				t.Error("Do failed")
+			}
+		}()
+	}
+	wg.Wait()
+	if calls.Load() >= 10 {
+		t.Error("Do failed to deduplicate")
+	}
+}
+
+func TestDoPanic(t *testing.T) {
+	g := Group[int]{Clone: func(v *int) *int { return v }}
+	started, release := make(chan struct{}), make(chan struct{})
+	leader := make(chan any)
+	go func() {
+		defer func() { leader <- recover() }()
+		g.Do("key", func() *result.Result[int] {
+			close(started)
+			<-release
+			panic("boom")
+		})
+	}()
+	<-started
+
+	waiter := make(chan *result.Result[int])
+	go func() {
+		waiter <- g.Do("key", func() *result.Result[int] {
+			t.Error("the duplicate call should have joined the panicking one")
+			return result.Ok(new(int))
+		})
+	}()
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+
+	if v := <-leader; v != "boom" {
+		t.Errorf("the original caller recovered %v", v)
+	}
+	var pe *result.PanicError
+	if r := <-waiter; !r.IsErr() || !errors.As(r.UnwrapError(), &pe) || pe.Value != "boom" {
+		t.Error("the duplicate caller should receive an Err of the panic")
+	}
+}
+
+func TestForget(t *testing.T) {
+	var g Group[int]
+	startedA, releaseA := make(chan struct{}), make(chan struct{})
+	doneA := make(chan struct{})
+	go func() {
+		defer close(doneA)
+		g.Do("key", func() *result.Result[int] {
+			close(startedA)
+			<-releaseA
+			return result.Ok(new(int))
+		})
+	}()
+	<-startedA
+	g.Forget("key")
+
+	startedB, releaseB := make(chan struct{}), make(chan struct{})
+	doneB := make(chan struct{})
+	go func() {
+		defer close(doneB)
+		g.Do("key", func() *result.Result[int] {
+			close(startedB)
+			<-releaseB
+			return result.Ok(new(int))
+		})
+	}()
+	<-startedB
+
+	close(releaseA)
+	<-doneA
+	g.mu.Lock()
+	_, ok := g.m["key"]
+	g.mu.Unlock()
+	if !ok {
+		t.Error("the forgotten call removed the newer in-flight call")
+	}
+	close(releaseB)
+	<-doneB
+}
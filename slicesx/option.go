@@ -0,0 +1,25 @@
+package slicesx
+
+import "github.com/yuanzicheng/go-result-and-option/option"
+
+// FilterMap applies `f` to every element and keeps the values of the [`Some`] results.
+func FilterMap[A any, B any](s []A, f func(A) *option.Option[B]) []B {
+	var out []B
+	for _, v := range s {
+		if o := f(v); o.IsSome() {
+			out = append(out, *o.UnwrapOrDefault())
+		}
+	}
+	return out
+}
+
+// CompactOptions returns the values of the [`Some`] options, dropping the [`None`] ones.
+func CompactOptions[T any](os []*option.Option[T]) []T {
+	var out []T
+	for _, o := range os {
+		if o.IsSome() {
+			out = append(out, *o.UnwrapOrDefault())
+		}
+	}
+	return out
+}
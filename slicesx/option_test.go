@@ -0,0 +1,29 @@
+package slicesx
+
+import (
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+)
+
+func TestFilterMap(t *testing.T) {
+	half := func(v int) *option.Option[int] {
+		if v%2 != 0 {
+			return option.None[int]()
+		}
+		h := v / 2
+		return option.Some(&h)
+	}
+	out := FilterMap([]int{1, 2, 3, 4}, half)
+	if len(out) != 2 || out[0] != 1 || out[1] != 2 {
+		t.Error("FilterMap failed")
+	}
+}
+
+func TestCompactOptions(t *testing.T) {
+	x := 1
+	out := CompactOptions([]*option.Option[int]{option.None[int](), option.Some(&x)})
+	if len(out) != 1 || out[0] != 1 {
+		t.Error("CompactOptions failed")
+	}
+}
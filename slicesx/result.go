@@ -0,0 +1,83 @@
+package slicesx
+
+import "github.com/yuanzicheng/go-result-and-option/result"
+
+// MapResult applies `f` to every element and returns an [`Ok`] of the values,
+// or stops at the first [`Err`] and returns it. A nil [`Ok`] value yields the zero value of `B`.
+func MapResult[A any, B any](s []A, f func(A) *result.Result[B]) *result.Result[[]B] {
+	return MapResultInto(make([]B, 0, len(s)), s, f)
+}
+
+// MapResultInto is like MapResult, but appends the values to `dst[:0]`,
+// reusing its backing array if it is large enough. The elements of `dst` are unspecified after an [`Err`].
+func MapResultInto[A any, B any](dst []B, s []A, f func(A) *result.Result[B]) *result.Result[[]B] {
+	out := grow(dst, len(s))
+	for i, v := range s {
+		r := f(v)
+		if r.IsErr() {
+			return result.PropagateErr[[]B](r)
+		}
+		out[i] = valueOf(r)
+	}
+	return result.Ok(&out)
+}
+
+// MapResults applies `f` to every element and returns all the results.
+func MapResults[A any, B any](s []A, f func(A) *result.Result[B]) []*result.Result[B] {
+	out := make([]*result.Result[B], len(s))
+	for i, v := range s {
+		out[i] = f(v)
+	}
+	return out
+}
+
+// Collect returns an [`Ok`] of the values of the results, or the first [`Err`].
+// A nil [`Ok`] value yields the zero value of `T`.
+func Collect[T any](rs []*result.Result[T]) *result.Result[[]T] {
+	return CollectInto(make([]T, 0, len(rs)), rs)
+}
+
+// CollectInto is like Collect, but appends the values to `dst[:0]`,
+// reusing its backing array if it is large enough. The elements of `dst` are unspecified after an [`Err`].
+func CollectInto[T any](dst []T, rs []*result.Result[T]) *result.Result[[]T] {
+	out := grow(dst, len(rs))
+	for i, r := range rs {
+		if r.IsErr() {
+			return result.PropagateErr[[]T](r)
+		}
+		out[i] = valueOf(r)
+	}
+	return result.Ok(&out)
+}
+
+// PartitionResults splits the results into the [`Ok`] values and the [`Err`] errors.
+// A nil [`Ok`] value yields the zero value of `T`.
+func PartitionResults[T any](rs []*result.Result[T]) ([]T, []error) {
+	values := make([]T, 0, len(rs))
+	var errs []error
+	for _, r := range rs {
+		if r.IsErr() {
+			errs = append(errs, r.UnwrapError())
+			continue
+		}
+		values = append(values, valueOf(r))
+	}
+	return values, errs
+}
+
+// grow returns `dst` resliced to `n` elements, allocating a new backing array only if `dst` is too small.
+func grow[T any](dst []T, n int) []T {
+	if cap(dst) < n {
+		return make([]T, n)
+	}
+	return dst[:n]
+}
+
+// valueOf returns the [`Ok`] value of the result, or the zero value of `T`.
+func valueOf[T any](r *result.Result[T]) T {
+	var v T
+	if p := r.Unwrap(); p != nil {
+		v = *p
+	}
+	return v
+}
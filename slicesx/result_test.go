@@ -0,0 +1,101 @@
+package slicesx
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+func atoi(s string) *result.Result[int] {
+	n, err := strconv.Atoi(s)
+	return result.New(&n, err)
+}
+
+func TestMapResult(t *testing.T) {
+	if vs := MapResult([]string{"1", "2"}, atoi).Unwrap(); (*vs)[1] != 2 {
+		t.Error("MapResult failed")
+	}
+	if MapResult([]string{"1", "x"}, atoi).IsOk() {
+		t.Error("MapResult failed")
+	}
+	rs := MapResults([]string{"1", "x"}, atoi)
+	if !rs[0].IsOk() || !rs[1].IsErr() {
+		t.Error("MapResults failed")
+	}
+}
+
+func TestPartitionResults(t *testing.T) {
+	values, errs := PartitionResults(MapResults([]string{"1", "x", "3"}, atoi))
+	if len(values) != 2 || values[1] != 3 || len(errs) != 1 {
+		t.Error("PartitionResults failed")
+	}
+}
+
+func TestCollect(t *testing.T) {
+	rs := MapResults([]string{"1", "2"}, atoi)
+	if vs := *Collect(rs).Unwrap(); len(vs) != 2 || vs[1] != 2 {
+		t.Error("Collect failed")
+	}
+	if Collect(MapResults([]string{"1", "x"}, atoi)).IsOk() {
+		t.Error("Collect did not return the Err")
+	}
+
+	buf := make([]int, 5, 8)
+	vs := *CollectInto(buf, rs).Unwrap()
+	if len(vs) != 2 || vs[1] != 2 || &vs[0] != &buf[0] {
+		t.Error("CollectInto did not reuse the buffer")
+	}
+	if vs := *MapResultInto(buf, []string{"3"}, atoi).Unwrap(); len(vs) != 1 || vs[0] != 3 || &vs[0] != &buf[0] {
+		t.Error("MapResultInto did not reuse the buffer")
+	}
+	if vs := *CollectInto(nil, rs).Unwrap(); len(vs) != 2 {
+		t.Error("CollectInto into nil failed")
+	}
+}
+
+var benchStrings = func() []string {
+	s := make([]string, 10000)
+	for i := range s {
+		s[i] = strconv.Itoa(i)
+	}
+	return s
+}()
+
+func BenchmarkMapResult(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		MapResult(benchStrings, atoi)
+	}
+}
+
+func BenchmarkMapResultInto(b *testing.B) {
+	b.ReportAllocs()
+	buf := make([]int, len(benchStrings))
+	for i := 0; i < b.N; i++ {
+		MapResultInto(buf, benchStrings, atoi)
+	}
+}
+
+func BenchmarkCollectInto(b *testing.B) {
+	b.ReportAllocs()
+	rs := MapResults(benchStrings, atoi)
+	buf := make([]int, len(rs))
+	for i := 0; i < b.N; i++ {
+		CollectInto(buf, rs)
+	}
+}
+
+func TestCollectDoesNotRecreateErr(t *testing.T) {
+	rs := []*result.Result[int]{atoi("1"), atoi("x")}
+	created := 0
+	result.SetErrHook(func(err error, meta result.Meta) {
+		if meta.Event == result.Created {
+			created++
+		}
+	})
+	defer result.SetErrHook(nil)
+	if !Collect(rs).IsErr() || created != 0 {
+		t.Errorf("Collect called the hook %d times", created)
+	}
+}
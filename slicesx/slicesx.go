@@ -0,0 +1,119 @@
+package slicesx
+
+import (
+	"cmp"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+)
+
+// First returns the first element of the slice, or [`None`] if it is empty.
+func First[T any](s []T) *option.Option[T] {
+	if len(s) == 0 {
+		return option.None[T]()
+	}
+	v := s[0]
+	return option.Some(&v)
+}
+
+// Last returns the last element of the slice, or [`None`] if it is empty.
+func Last[T any](s []T) *option.Option[T] {
+	if len(s) == 0 {
+		return option.None[T]()
+	}
+	v := s[len(s)-1]
+	return option.Some(&v)
+}
+
+// Find returns the first element satisfying the predicate, or [`None`] if there is none.
+func Find[T any](s []T, pred func(T) bool) *option.Option[T] {
+	for _, v := range s {
+		if pred(v) {
+			return option.Some(&v)
+		}
+	}
+	return option.None[T]()
+}
+
+// FindIndex returns the index of the first element satisfying the predicate, or [`None`] if there is none.
+func FindIndex[T any](s []T, pred func(T) bool) *option.Option[int] {
+	for i, v := range s {
+		if pred(v) {
+			return option.Some(&i)
+		}
+	}
+	return option.None[int]()
+}
+
+// At returns the element at index `i`, or [`None`] if `i` is out of range (including negative).
+func At[T any](s []T, i int) *option.Option[T] {
+	if i < 0 || i >= len(s) {
+		return option.None[T]()
+	}
+	v := s[i]
+	return option.Some(&v)
+}
+
+// AtNeg is like At, but a negative index counts back from the end of the slice,
+// Python-style: -1 is the last element.
+func AtNeg[T any](s []T, i int) *option.Option[T] {
+	if i < 0 {
+		i += len(s)
+	}
+	return At(s, i)
+}
+
+// MinBy returns the minimal element according to `cmp`, or [`None`] if the slice is empty.
+// If several elements are equally minimal, the first one is returned.
+func MinBy[T any](s []T, cmp func(a, b T) int) *option.Option[T] {
+	if len(s) == 0 {
+		return option.None[T]()
+	}
+	m := s[0]
+	for _, v := range s[1:] {
+		if cmp(v, m) < 0 {
+			m = v
+		}
+	}
+	return option.Some(&m)
+}
+
+// MaxBy returns the maximal element according to `cmp`, or [`None`] if the slice is empty.
+// If several elements are equally maximal, the first one is returned.
+func MaxBy[T any](s []T, cmp func(a, b T) int) *option.Option[T] {
+	return MinBy(s, func(a, b T) int {
+		return cmp(b, a)
+	})
+}
+
+// MinByKey returns the element with the minimal key, or [`None`] if the slice is empty.
+func MinByKey[T any, K cmp.Ordered](s []T, key func(T) K) *option.Option[T] {
+	return MinBy(s, func(a, b T) int {
+		return cmp.Compare(key(a), key(b))
+	})
+}
+
+// MaxByKey returns the element with the maximal key, or [`None`] if the slice is empty.
+func MaxByKey[T any, K cmp.Ordered](s []T, key func(T) K) *option.Option[T] {
+	return MaxBy(s, func(a, b T) int {
+		return cmp.Compare(key(a), key(b))
+	})
+}
+
+// Reduce folds the elements with `f`, starting from the first one,
+// or returns [`None`] if the slice is empty.
+func Reduce[T any](s []T, f func(T, T) T) *option.Option[T] {
+	if len(s) == 0 {
+		return option.None[T]()
+	}
+	acc := Fold(s[1:], s[0], f)
+	return option.Some(&acc)
+}
+
+// Fold folds the elements with `f`, starting from `init`.
+func Fold[T any, A any](s []T, init A, f func(A, T) A) A {
+	acc := init
+	for _, v := range s {
+		acc = f(acc, v)
+	}
+	return acc
+}
@@ -0,0 +1,63 @@
+package slicesx
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFirstLast(t *testing.T) {
+	s := []int{1, 2, 3}
+	if *First(s).Unwrap("") != 1 || *Last(s).Unwrap("") != 3 {
+		t.Error("First/Last failed")
+	}
+	if First([]int{}).IsSome() || Last[int](nil).IsSome() {
+		t.Error("First/Last failed on empty slice")
+	}
+}
+
+func TestFind(t *testing.T) {
+	s := []int{1, 2, 3, 4}
+	even := func(v int) bool { return v%2 == 0 }
+	if *Find(s, even).Unwrap("") != 2 || *FindIndex(s, even).Unwrap("") != 1 {
+		t.Error("Find/FindIndex failed")
+	}
+	big := func(v int) bool { return v > 10 }
+	if Find(s, big).IsSome() || FindIndex(s, big).IsSome() {
+		t.Error("Find/FindIndex failed")
+	}
+}
+
+func TestAt(t *testing.T) {
+	s := []int{1, 2, 3}
+	if *At(s, 1).Unwrap("") != 2 || At(s, 3).IsSome() || At(s, -1).IsSome() {
+		t.Error("At failed")
+	}
+	if *AtNeg(s, -1).Unwrap("") != 3 || *AtNeg(s, 0).Unwrap("") != 1 || AtNeg(s, -4).IsSome() {
+		t.Error("AtNeg failed")
+	}
+}
+
+func TestMinMax(t *testing.T) {
+	s := []string{"bb", "a", "ccc", "dd"}
+	if *MinBy(s, strings.Compare).Unwrap("") != "a" || *MaxBy(s, strings.Compare).Unwrap("") != "dd" {
+		t.Error("MinBy/MaxBy failed")
+	}
+	length := func(s string) int { return len(s) }
+	if *MinByKey(s, length).Unwrap("") != "a" || *MaxByKey(s, length).Unwrap("") != "ccc" {
+		t.Error("MinByKey/MaxByKey failed")
+	}
+	if MinBy(nil, strings.Compare).IsSome() {
+		t.Error("MinBy failed on empty slice")
+	}
+}
+
+func TestReduce(t *testing.T) {
+	add := func(a, b int) int { return a + b }
+	if *Reduce([]int{1, 2, 3}, add).Unwrap("") != 6 || Reduce(nil, add).IsSome() {
+		t.Error("Reduce failed")
+	}
+	n := Fold([]string{"a", "bb"}, 1, func(acc int, s string) int { return acc + len(s) })
+	if n != 4 {
+		t.Error("Fold failed")
+	}
+}
@@ -0,0 +1,109 @@
+// Package sortx sorts slices of options and results, placing the [`None`] options
+// and the [`Err`] results before or after the values.
+package sortx
+
+import (
+	"slices"
+
+	"github.com/yuanzicheng/go-result-and-option/internal/peek"
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// Placement tells where the [`None`] options or the [`Err`] results are placed.
+type Placement int
+
+const (
+	// Last places them after the values.
+	Last Placement = iota
+	// First places them before the values.
+	First
+)
+
+// missing orders a missing element against a present one according to the placement.
+func (p Placement) missing(aMissing, bMissing bool) int {
+	switch {
+	case aMissing == bMissing:
+		return 0
+	case aMissing == (p == First):
+		return -1
+	default:
+		return 1
+	}
+}
+
+// Options sorts the options in place by their values with `cmp`, placing the [`None`] ones according to `none`.
+// The sort is stable, so the [`None`] options keep their relative order. A nil option counts as [`None`].
+func Options[T any](s []*option.Option[T], cmp func(a, b T) int, none Placement) {
+	slices.SortStableFunc(s, func(a, b *option.Option[T]) int {
+		av, aok := deref(a)
+		bv, bok := deref(b)
+		if !aok || !bok {
+			return none.missing(!aok, !bok)
+		}
+		return cmp(av, bv)
+	})
+}
+
+// Results sorts the results in place by their values with `cmp`, placing the [`Err`] ones according to `errs`.
+// The sort is stable, so the [`Err`] results keep their relative order.
+// A nil [`Ok`] value is compared as the zero value of `T`.
+func Results[T any](s []*result.Result[T], cmp func(a, b T) int, errs Placement) {
+	slices.SortStableFunc(s, func(a, b *result.Result[T]) int {
+		aerr, berr := errOf(a), errOf(b)
+		if aerr != nil || berr != nil {
+			return errs.missing(aerr != nil, berr != nil)
+		}
+		return cmp(valueOf(a), valueOf(b))
+	})
+}
+
+// DedupOptions removes consecutive duplicates, as slices.CompactFunc does, keeping the first of each run.
+// Two [`None`] options are duplicates. Applied after Options, it leaves every value and at most one [`None`].
+func DedupOptions[T any](s []*option.Option[T], cmp func(a, b T) int) []*option.Option[T] {
+	return slices.CompactFunc(s, func(a, b *option.Option[T]) bool {
+		av, aok := deref(a)
+		bv, bok := deref(b)
+		if !aok || !bok {
+			return aok == bok
+		}
+		return cmp(av, bv) == 0
+	})
+}
+
+// DedupResults removes consecutive duplicates, as slices.CompactFunc does, keeping the first of each run.
+// Two [`Err`] results are duplicates if their errors have the same message.
+func DedupResults[T any](s []*result.Result[T], cmp func(a, b T) int) []*result.Result[T] {
+	return slices.CompactFunc(s, func(a, b *result.Result[T]) bool {
+		aerr, berr := errOf(a), errOf(b)
+		if aerr != nil || berr != nil {
+			return aerr != nil && berr != nil && aerr.Error() == berr.Error()
+		}
+		return cmp(valueOf(a), valueOf(b)) == 0
+	})
+}
+
+// deref, errOf and valueOf read the options and the results through package peek,
+// so sorting them neither calls the hooks nor consumes them.
+func deref[T any](o *option.Option[T]) (T, bool) {
+	p, _ := peek.Option(o).(*T)
+	if p == nil {
+		var zero T
+		return zero, false
+	}
+	return *p, true
+}
+
+func errOf[T any](r *result.Result[T]) error {
+	_, err := peek.Result(r)
+	return err
+}
+
+func valueOf[T any](r *result.Result[T]) T {
+	v, _ := peek.Result(r)
+	if p := v.(*T); p != nil {
+		return *p
+	}
+	var zero T
+	return zero
+}
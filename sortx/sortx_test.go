@@ -0,0 +1,91 @@
+package sortx
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+func some(v int) *option.Option[int] { return option.Some(&v) }
+
+func ok(v int) *result.Result[int] { return result.Ok(&v) }
+
+func fail(msg string) *result.Result[int] { return result.Err[int](errors.New(msg)) }
+
+func showOptions(s []*option.Option[int]) string {
+	var out []string
+	for _, o := range s {
+		if v, ok := deref(o); ok {
+			out = append(out, fmt.Sprint(v))
+		} else {
+			out = append(out, "")
+		}
+	}
+	return strings.Join(out, ",")
+}
+
+func showResults(s []*result.Result[int]) string {
+	var out []string
+	for _, r := range s {
+		if r.IsErr() {
+			out = append(out, r.UnwrapError().Error())
+		} else {
+			out = append(out, fmt.Sprint(*r.Unwrap()))
+		}
+	}
+	return strings.Join(out, ",")
+}
+
+func TestOptions(t *testing.T) {
+	s := []*option.Option[int]{some(3), option.None[int](), some(1), nil, some(3)}
+	Options(s, cmp.Compare[int], Last)
+	if got := showOptions(s); got != "1,3,3,," {
+		t.Errorf("Options(Last) = %s", got)
+	}
+	Options(s, cmp.Compare[int], First)
+	if got := showOptions(s); got != ",,1,3,3" {
+		t.Errorf("Options(First) = %s", got)
+	}
+	if got := showOptions(DedupOptions(s, cmp.Compare[int])); got != ",1,3" {
+		t.Errorf("DedupOptions = %s", got)
+	}
+}
+
+func TestResults(t *testing.T) {
+	s := []*result.Result[int]{fail("b"), ok(2), fail("a"), ok(1), fail("a")}
+	Results(s, cmp.Compare[int], First)
+	if got := showResults(s); got != "b,a,a,1,2" {
+		t.Errorf("Results(First) = %s", got)
+	}
+	if got := showResults(DedupResults(s, cmp.Compare[int])); got != "b,a,1,2" {
+		t.Errorf("DedupResults = %s", got)
+	}
+	s = []*result.Result[int]{fail("b"), ok(2), ok(1)}
+	Results(s, cmp.Compare[int], Last)
+	if got := showResults(s); got != "1,2,b" {
+		t.Errorf("Results(Last) = %s", got)
+	}
+}
+
+func TestSortDoesNotFireHooks(t *testing.T) {
+	x := 1
+	rs := []*result.Result[int]{result.Err[int](errors.New("a")), result.Ok(&x), result.Err[int](errors.New("a"))}
+	os := []*option.Option[int]{option.None[int](), option.Some(&x)}
+	fired := 0
+	result.SetErrHook(func(error, result.Meta) { fired++ })
+	defer result.SetErrHook(nil)
+	option.SetNoneHook(func(option.Meta) { fired++ })
+	defer option.SetNoneHook(nil)
+
+	Results(rs, cmp.Compare, Last)
+	rs = DedupResults(rs, cmp.Compare)
+	Options(os, cmp.Compare, Last)
+	if fired != 0 {
+		t.Errorf("sorting called the hooks %d times", fired)
+	}
+}
@@ -0,0 +1,76 @@
+package sqlx
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+)
+
+// fakeDriver serves queries of the form "rows:<columns>:<row>;<row>...",
+// each row holding comma-separated values, "NULL" standing for nil.
+type fakeDriver struct{}
+
+func (fakeDriver) Open(string) (driver.Conn, error) {
+	return fakeConn{}, nil
+}
+
+type fakeConn struct{}
+
+func (fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return nil, errors.New("not supported")
+}
+func (fakeConn) Close() error              { return nil }
+func (fakeConn) Begin() (driver.Tx, error) { return nil, errors.New("not supported") }
+
+func (fakeConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	parts := strings.SplitN(query, ":", 3)
+	if len(parts) != 3 || parts[0] != "rows" {
+		return nil, errors.New("bad query")
+	}
+	rows := &fakeRows{columns: strings.Split(parts[1], ",")}
+	if parts[2] != "" {
+		for _, row := range strings.Split(parts[2], ";") {
+			var values []driver.Value
+			for _, v := range strings.Split(row, ",") {
+				if v == "NULL" {
+					values = append(values, nil)
+				} else {
+					values = append(values, v)
+				}
+			}
+			rows.rows = append(rows.rows, values)
+		}
+	}
+	return rows, nil
+}
+
+type fakeRows struct {
+	columns []string
+	rows    [][]driver.Value
+}
+
+func (r *fakeRows) Columns() []string { return r.columns }
+func (r *fakeRows) Close() error      { return nil }
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.rows) == 0 {
+		return io.EOF
+	}
+	copy(dest, r.rows[0])
+	r.rows = r.rows[1:]
+	return nil
+}
+
+func init() {
+	sql.Register("sqlx-fake", fakeDriver{})
+}
+
+func openFake() *sql.DB {
+	db, err := sql.Open("sqlx-fake", "")
+	if err != nil {
+		panic(err)
+	}
+	return db
+}
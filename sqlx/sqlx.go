@@ -0,0 +1,93 @@
+package sqlx
+
+import (
+	"context"
+	"database/sql"
+	"iter"
+	"reflect"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// Querier runs queries, it is implemented by `*sql.DB`, `*sql.Tx` and `*sql.Conn`.
+type Querier interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// scan scans the current row into a `T`. Struct types are mapped column by column,
+// see Columns, while other types are scanned from a single column.
+func scan[T any](rows *sql.Rows) (T, error) {
+	var v T
+	if isStruct(reflect.TypeFor[T]()) {
+		err := scanStruct(rows, reflect.ValueOf(&v))
+		return v, err
+	}
+	err := rows.Scan(&v)
+	return v, err
+}
+
+// QueryOne returns the first row of the query scanned into a `T`,
+// or [`Ok`] of [`None`] if the query returns no rows.
+func QueryOne[T any](ctx context.Context, db Querier, query string, args ...any) *result.Result[option.Option[T]] {
+	rows, err := db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return result.Err[option.Option[T]](err)
+	}
+	defer rows.Close()
+	if !rows.Next() {
+		if err := rows.Err(); err != nil {
+			return result.Err[option.Option[T]](err)
+		}
+		return result.Ok(option.None[T]())
+	}
+	v, err := scan[T](rows)
+	if err != nil {
+		return result.Err[option.Option[T]](err)
+	}
+	if err := rows.Close(); err != nil {
+		return result.Err[option.Option[T]](err)
+	}
+	return result.Ok(option.Some(&v))
+}
+
+// QueryRow returns the first row of the query scanned into a `T`,
+// or an [`Err`] of `sql.ErrNoRows` tagged with `errkind.NotFound` if the query returns no rows.
+func QueryRow[T any](ctx context.Context, db Querier, query string, args ...any) *result.Result[T] {
+	r := QueryOne[T](ctx, db, query, args...)
+	if r.IsErr() {
+		return result.Err[T](r.UnwrapError())
+	}
+	if o := r.Unwrap(); o.IsSome() {
+		return result.Ok(o.UnwrapOrDefault())
+	}
+	return result.Err[T](errkind.Wrap(errkind.NotFound, sql.ErrNoRows))
+}
+
+// QueryIter yields the rows of the query scanned into `T` values,
+// ending with an [`Err`] if the query or the iteration fails.
+// The rows are closed once the iteration ends.
+func QueryIter[T any](ctx context.Context, db Querier, query string, args ...any) iter.Seq[*result.Result[T]] {
+	return func(yield func(*result.Result[T]) bool) {
+		rows, err := db.QueryContext(ctx, query, args...)
+		if err != nil {
+			yield(result.Err[T](err))
+			return
+		}
+		defer rows.Close()
+		for rows.Next() {
+			v, err := scan[T](rows)
+			if err != nil {
+				yield(result.Err[T](err))
+				return
+			}
+			if !yield(result.Ok(&v)) {
+				return
+			}
+		}
+		if err := rows.Err(); err != nil {
+			yield(result.Err[T](err))
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package sqlx
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+)
+
+func TestQuery(t *testing.T) {
+	db := openFake()
+	defer db.Close()
+	ctx := context.Background()
+
+	if *QueryRow[string](ctx, db, "rows:name:alice;bob").Unwrap() != "alice" {
+		t.Error("QueryRow failed")
+	}
+	err := QueryRow[string](ctx, db, "rows:name:").UnwrapError()
+	if !errors.Is(err, sql.ErrNoRows) || !errors.Is(err, errkind.NotFound) {
+		t.Error("QueryRow failed to map no rows")
+	}
+	if !QueryOne[string](ctx, db, "rows:name:").Unwrap().IsNone() {
+		t.Error("QueryOne failed")
+	}
+	if QueryOne[int](ctx, db, "rows:n:x").IsOk() || QueryOne[int](ctx, db, "bad").IsOk() {
+		t.Error("QueryOne failed")
+	}
+
+	var names []string
+	for r := range QueryIter[string](ctx, db, "rows:name:alice;bob") {
+		names = append(names, *r.Unwrap())
+	}
+	if len(names) != 2 || names[1] != "bob" {
+		t.Error("QueryIter failed")
+	}
+}
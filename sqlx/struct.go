@@ -0,0 +1,102 @@
+package sqlx
+
+import (
+	"database/sql"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/yuanzicheng/go-result-and-option/internal/fields"
+)
+
+var (
+	scannerType = reflect.TypeFor[sql.Scanner]()
+	timeType    = reflect.TypeFor[time.Time]()
+)
+
+// isStruct reports whether rows are mapped to the fields of `t` rather than scanned into it.
+func isStruct(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct && t != timeType && !fields.IsOption(t) && !reflect.PointerTo(t).Implements(scannerType)
+}
+
+// scanStruct scans the current row into the fields of the struct pointed to by `dst`.
+// A NULL column leaves a [`None`] in an `Option` field, other values a [`Some`].
+func scanStruct(rows *sql.Rows, dst reflect.Value) error {
+	columns, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	v := dst.Elem()
+	targets := make([]any, len(columns))
+	var options []reflect.Value
+	var holders []reflect.Value
+	for i, column := range columns {
+		f, ok := fields.ByName(v, "db", column)
+		if !ok {
+			return fmt.Errorf("sqlx: no field for column %q in %v", column, v.Type())
+		}
+		if fields.IsOption(f.Type()) {
+			// Scanning into a **T leaves a nil *T for NULL.
+			holder := reflect.New(reflect.PointerTo(fields.OptionElem(f.Type())))
+			targets[i] = holder.Interface()
+			options = append(options, f)
+			holders = append(holders, holder)
+			continue
+		}
+		targets[i] = f.Addr().Interface()
+	}
+	if err := rows.Scan(targets...); err != nil {
+		return err
+	}
+	for i, f := range options {
+		f.Addr().MethodByName("Replace").Call([]reflect.Value{holders[i].Elem()})
+	}
+	return nil
+}
+
+// Columns returns the columns mapped to the fields of the struct `v`,
+// from their `db` tags or their names.
+func Columns(v any) []string {
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	var columns []string
+	for i := range t.NumField() {
+		if name := fields.Name(t.Field(i), "db"); name != "" {
+			columns = append(columns, name)
+		}
+	}
+	return columns
+}
+
+// Args returns the values of the fields of the struct `v` mapped to the columns,
+// in order, as query arguments. A [`None`] option is bound as NULL and a [`Some`] as its value.
+// Without columns, all the mapped fields are returned in the order of Columns.
+func Args(v any, columns ...string) ([]any, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer {
+		rv = rv.Elem()
+	}
+	if len(columns) == 0 {
+		columns = Columns(v)
+	}
+	args := make([]any, len(columns))
+	for i, column := range columns {
+		f, ok := fields.ByName(rv, "db", column)
+		if !ok {
+			return nil, fmt.Errorf("sqlx: no field for column %q in %v", column, rv.Type())
+		}
+		if fields.IsOption(f.Type()) {
+			p := fields.OptionValue(f)
+			if p.IsNil() {
+				args[i] = nil
+			} else {
+				args[i] = p.Elem().Interface()
+			}
+			continue
+		}
+		args[i] = f.Interface()
+	}
+	return args, nil
+}
@@ -0,0 +1,54 @@
+package sqlx
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+)
+
+type user struct {
+	ID       string                `db:"id"`
+	Nickname option.Option[string] `db:"nickname"`
+	Age      option.Option[int64]
+	internal int
+}
+
+func TestScanStruct(t *testing.T) {
+	db := openFake()
+	defer db.Close()
+	ctx := context.Background()
+
+	var users []user
+	for r := range QueryIter[user](ctx, db, "rows:id,nickname,age:1,al,30;2,NULL,NULL") {
+		users = append(users, *r.Unwrap())
+	}
+	if len(users) != 2 {
+		t.Fatal("QueryIter failed")
+	}
+	if *users[0].Nickname.Unwrap("") != "al" || *users[0].Age.Unwrap("") != 30 {
+		t.Error("scan failed to map Some")
+	}
+	if users[1].ID != "2" || users[1].Nickname.IsSome() || users[1].Age.IsSome() {
+		t.Error("scan failed to map NULL to None")
+	}
+	if QueryRow[user](ctx, db, "rows:id,unknown:1,x").IsOk() {
+		t.Error("scan failed to reject an unknown column")
+	}
+}
+
+func TestArgs(t *testing.T) {
+	nick := "al"
+	u := user{ID: "1", Nickname: *option.Some(&nick)}
+	if !slices.Equal(Columns(u), []string{"id", "nickname", "Age"}) {
+		t.Errorf("Columns failed: %v", Columns(u))
+	}
+	args, err := Args(&u)
+	if err != nil || args[0] != "1" || args[1] != "al" || args[2] != nil {
+		t.Errorf("Args failed: %v %v", args, err)
+	}
+	if _, err := Args(u, "missing"); err == nil {
+		t.Error("Args failed to reject an unknown column")
+	}
+}
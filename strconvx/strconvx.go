@@ -0,0 +1,53 @@
+package strconvx
+
+import (
+	"strconv"
+	"unsafe"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// Integer is satisfied by every integer type.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Float is satisfied by every floating-point type.
+type Float interface {
+	~float32 | ~float64
+}
+
+// ParseInt parses a base 10 integer fitting in `T`.
+func ParseInt[T Integer](s string) *result.Result[T] {
+	var v T
+	bits := int(unsafe.Sizeof(v)) * 8
+	if ^v < 0 {
+		n, err := strconv.ParseInt(s, 10, bits)
+		v = T(n)
+		return result.New(&v, err)
+	}
+	n, err := strconv.ParseUint(s, 10, bits)
+	v = T(n)
+	return result.New(&v, err)
+}
+
+// ParseFloat parses a floating-point number fitting in `T`.
+func ParseFloat[T Float](s string) *result.Result[T] {
+	var v T
+	n, err := strconv.ParseFloat(s, int(unsafe.Sizeof(v))*8)
+	v = T(n)
+	return result.New(&v, err)
+}
+
+// ParseBool parses a boolean value as `strconv.ParseBool` does.
+func ParseBool(s string) *result.Result[bool] {
+	v, err := strconv.ParseBool(s)
+	return result.New(&v, err)
+}
+
+// Atoi is equivalent to ParseInt[int].
+func Atoi(s string) *result.Result[int] {
+	v, err := strconv.Atoi(s)
+	return result.New(&v, err)
+}
@@ -0,0 +1,31 @@
+package strconvx
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestParseInt(t *testing.T) {
+	if *ParseInt[int8]("-128").Unwrap() != -128 {
+		t.Error("ParseInt failed")
+	}
+	if !errors.Is(ParseInt[int8]("128").UnwrapError(), strconv.ErrRange) {
+		t.Error("ParseInt failed to check the range")
+	}
+	if *ParseInt[uint16]("65535").Unwrap() != 65535 || ParseInt[uint]("-1").IsOk() {
+		t.Error("ParseInt failed on unsigned")
+	}
+}
+
+func TestParse(t *testing.T) {
+	if *ParseFloat[float32]("1.5").Unwrap() != 1.5 || ParseFloat[float64]("x").IsOk() {
+		t.Error("ParseFloat failed")
+	}
+	if !*ParseBool("true").Unwrap() || ParseBool("yes").IsOk() {
+		t.Error("ParseBool failed")
+	}
+	if *Atoi("42").Unwrap() != 42 || Atoi("").IsOk() {
+		t.Error("Atoi failed")
+	}
+}
@@ -0,0 +1,75 @@
+package syncx
+
+import (
+	"sync/atomic"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// AtomicOption is an `Option[T]` that can be read and written atomically.
+// The zero value is a [`None`].
+type AtomicOption[T any] struct {
+	p atomic.Pointer[T]
+}
+
+// Load returns the current value, [`None`] if nothing has been stored.
+func (a *AtomicOption[T]) Load() *option.Option[T] {
+	return option.New(a.p.Load())
+}
+
+// Store sets the value, storing nil leaves a [`None`].
+func (a *AtomicOption[T]) Store(v *T) {
+	a.p.Store(v)
+}
+
+// Swap stores the new value and returns the old one.
+func (a *AtomicOption[T]) Swap(v *T) *option.Option[T] {
+	return option.New(a.p.Swap(v))
+}
+
+// CompareAndSwap stores `new` if the current value is `old`, nil standing for [`None`].
+func (a *AtomicOption[T]) CompareAndSwap(old, new *T) bool {
+	return a.p.CompareAndSwap(old, new)
+}
+
+// Take takes the value out, leaving a [`None`] in its place.
+func (a *AtomicOption[T]) Take() *option.Option[T] {
+	return a.Swap(nil)
+}
+
+// AtomicResult is a `Result[T]` cell that can be read and written atomically.
+// The zero value holds no result.
+type AtomicResult[T any] struct {
+	p atomic.Pointer[result.Result[T]]
+}
+
+// Load returns the current result, [`None`] if nothing has been stored.
+func (a *AtomicResult[T]) Load() *option.Option[result.Result[T]] {
+	return option.New(a.p.Load())
+}
+
+// Store sets the result.
+func (a *AtomicResult[T]) Store(r *result.Result[T]) {
+	a.p.Store(r)
+}
+
+// StoreIfOk sets the result only if it is [`Ok`], keeping the last known good one otherwise.
+// Returns `true` if the result was stored.
+func (a *AtomicResult[T]) StoreIfOk(r *result.Result[T]) bool {
+	if r.IsErr() {
+		return false
+	}
+	a.p.Store(r)
+	return true
+}
+
+// Swap stores the new result and returns the old one.
+func (a *AtomicResult[T]) Swap(r *result.Result[T]) *option.Option[result.Result[T]] {
+	return option.New(a.p.Swap(r))
+}
+
+// CompareAndSwap stores `new` if the current result is `old`.
+func (a *AtomicResult[T]) CompareAndSwap(old, new *result.Result[T]) bool {
+	return a.p.CompareAndSwap(old, new)
+}
@@ -0,0 +1,38 @@
+package syncx
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+func TestAtomicOption(t *testing.T) {
+	var a AtomicOption[int]
+	if !a.Load().IsNone() {
+		t.Error("Load failed")
+	}
+
+	x, y := 1, 2
+	a.Store(&x)
+	if !a.CompareAndSwap(&x, &y) || *a.Load().Unwrap("") != 2 {
+		t.Error("CompareAndSwap failed")
+	}
+	if old := a.Take(); *old.Unwrap("") != 2 || !a.Load().IsNone() {
+		t.Error("Take failed")
+	}
+}
+
+func TestAtomicResult(t *testing.T) {
+	var a AtomicResult[int]
+	x := 1
+	if !a.StoreIfOk(result.Ok(&x)) {
+		t.Error("StoreIfOk failed")
+	}
+	if a.StoreIfOk(result.Err[int](errors.New("boom"))) {
+		t.Error("StoreIfOk failed")
+	}
+	if r := a.Load().Unwrap(""); *r.Unwrap() != 1 {
+		t.Error("Load failed")
+	}
+}
@@ -0,0 +1,79 @@
+package syncx
+
+import (
+	"sync"
+	"time"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// ExpiringOption is an `Option[T]` whose value reads as [`None`] once its time to live has passed.
+// Every access is serialized by a mutex. The zero value is a [`None`].
+type ExpiringOption[T any] struct {
+	mu      sync.Mutex
+	o       option.Option[T]
+	expires time.Time
+}
+
+// Set replaces the value, which expires after `ttl`; a non-positive `ttl` never expires.
+func (e *ExpiringOption[T]) Set(v *T, ttl time.Duration) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.set(v, ttl)
+}
+
+func (e *ExpiringOption[T]) set(v *T, ttl time.Duration) {
+	e.o.Replace(v)
+	e.expires = time.Time{}
+	if ttl > 0 {
+		e.expires = time.Now().Add(ttl)
+	}
+}
+
+// get must be called with the lock held.
+func (e *ExpiringOption[T]) get() *option.Option[T] {
+	if e.o.IsNone() {
+		return option.None[T]()
+	}
+	if !e.expires.IsZero() && !time.Now().Before(e.expires) {
+		e.o.Take()
+		return option.None[T]()
+	}
+	return copied(e.o.UnwrapOrDefault())
+}
+
+// Get returns a copy of the value, or [`None`] if there is none or it has expired.
+func (e *ExpiringOption[T]) Get() *option.Option[T] {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.get()
+}
+
+// Clear removes the value.
+func (e *ExpiringOption[T]) Clear() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.o.Take()
+}
+
+// GetOrRefresh returns the value if it has not expired.
+// Otherwise it calls `loader` and, if the result is [`Ok`], stores the value for `ttl`.
+// Concurrent callers wait for a single refresh instead of each calling `loader`.
+// An [`Err`] is returned as is and leaves the option empty, and an [`Ok`] of nil stores the zero value.
+func (e *ExpiringOption[T]) GetOrRefresh(ttl time.Duration, loader func() *result.Result[T]) *result.Result[T] {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if o := e.get(); o.IsSome() {
+		return result.Ok(o.Unwrap(""))
+	}
+	r := loader()
+	if r.IsOk() {
+		var v T
+		if p := r.Unwrap(); p != nil {
+			v = *p
+		}
+		e.set(&v, ttl)
+	}
+	return r
+}
@@ -0,0 +1,88 @@
+package syncx
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+func TestExpiringOption(t *testing.T) {
+	var e ExpiringOption[int]
+	if !e.Get().IsNone() {
+		t.Error("zero value is not None")
+	}
+
+	x := 1
+	e.Set(&x, time.Hour)
+	if *e.Get().Unwrap("") != 1 {
+		t.Error("Set failed")
+	}
+	e.Set(&x, time.Millisecond)
+	time.Sleep(5 * time.Millisecond)
+	if !e.Get().IsNone() {
+		t.Error("value did not expire")
+	}
+	e.Set(&x, 0)
+	if !e.Get().IsSome() {
+		t.Error("zero ttl expired")
+	}
+	e.Clear()
+	if !e.Get().IsNone() {
+		t.Error("Clear failed")
+	}
+}
+
+func TestExpiringOptionGetOrRefresh(t *testing.T) {
+	var e ExpiringOption[int]
+	boom := errors.New("boom")
+	r := e.GetOrRefresh(time.Hour, func() *result.Result[int] { return result.Err[int](boom) })
+	if !errors.Is(r.UnwrapError(), boom) || !e.Get().IsNone() {
+		t.Error("Err was stored")
+	}
+
+	var calls atomic.Int32
+	loader := func() *result.Result[int] {
+		n := int(calls.Add(1))
+		return result.Ok(&n)
+	}
+	var wg sync.WaitGroup
+	for range 100 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if *e.GetOrRefresh(time.Hour, loader).Unwrap() != 1 {
+				t.Error("GetOrRefresh returned a stale value")
+			}
+		}()
+	}
+	wg.Wait()
+	if calls.Load() != 1 {
+		t.Errorf("loader called %d times", calls.Load())
+	}
+
+	e.Set(e.Get().Unwrap(""), time.Millisecond)
+	time.Sleep(5 * time.Millisecond)
+	if *e.GetOrRefresh(time.Hour, loader).Unwrap() != 2 {
+		t.Error("GetOrRefresh did not refresh")
+	}
+}
+
+func TestExpiringOptionCopies(t *testing.T) {
+	var e ExpiringOption[int]
+	x := 1
+	e.Set(&x, time.Hour)
+	*e.Get().Unwrap("") = 2
+	if x != 1 || *e.Get().Unwrap("") != 1 {
+		t.Error("Get did not return a copy")
+	}
+
+	e.Clear()
+	r := e.GetOrRefresh(time.Hour, func() *result.Result[int] { return result.Ok[int](nil) })
+	if !r.IsOk() || *e.Get().Unwrap("") != 0 {
+		t.Error("GetOrRefresh did not store the zero value of an Ok of nil")
+	}
+}
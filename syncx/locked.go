@@ -0,0 +1,56 @@
+package syncx
+
+import (
+	"sync"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+)
+
+// LockedOption is an `Option[T]` whose every access is serialized by a mutex.
+// The zero value is a [`None`].
+type LockedOption[T any] struct {
+	mu sync.Mutex
+	o  option.Option[T]
+}
+
+// With calls `f` with the option while holding the lock.
+// The option must not be retained after `f` returns.
+func (l *LockedOption[T]) With(f func(*option.Option[T])) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	f(&l.o)
+}
+
+// Get returns a copy of the option.
+func (l *LockedOption[T]) Get() *option.Option[T] {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return copied(l.o.UnwrapOrDefault())
+}
+
+// copied returns an option of a copy of `*p`, so the caller doesn't share it, or [`None`] if `p` is nil.
+func copied[T any](p *T) *option.Option[T] {
+	if p == nil {
+		return option.None[T]()
+	}
+	v := *p
+	return option.Some(&v)
+}
+
+// Set replaces the value, returning a copy of the old value if present.
+func (l *LockedOption[T]) Set(v *T) *option.Option[T] {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return copied(l.o.Replace(v))
+}
+
+// TakeIf takes a copy of the value out, leaving a [`None`] in its place,
+// but only if there is a value and the predicate evaluates to `true`.
+func (l *LockedOption[T]) TakeIf(f func(*T) bool) *option.Option[T] {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.o.IsNone() {
+		return option.None[T]()
+	}
+	return copied(l.o.TakeIf(f))
+}
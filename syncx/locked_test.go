@@ -0,0 +1,56 @@
+package syncx
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+)
+
+func TestLockedOption(t *testing.T) {
+	var l LockedOption[int]
+	if !l.TakeIf(func(*int) bool { return true }).IsNone() {
+		t.Error("TakeIf failed")
+	}
+
+	x := 0
+	l.Set(&x)
+	var wg sync.WaitGroup
+	for range 100 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			l.With(func(o *option.Option[int]) {
+				*o.Unwrap("") += 1
+			})
+		}()
+	}
+	wg.Wait()
+	if *l.Get().Unwrap("") != 100 {
+		t.Error("With failed")
+	}
+
+	if v := l.TakeIf(func(v *int) bool { return *v == 100 }); v.IsNone() || !l.Get().IsNone() {
+		t.Error("TakeIf failed")
+	}
+}
+
+func TestLockedOptionCopies(t *testing.T) {
+	var l LockedOption[int]
+	x := 1
+	l.Set(&x)
+	*l.Get().Unwrap("") = 2
+	if x != 1 || *l.Get().Unwrap("") != 1 {
+		t.Error("Get did not return a copy")
+	}
+	y := 3
+	old := l.Set(&y)
+	*old.Unwrap("") = 4
+	if x != 1 {
+		t.Error("Set did not return a copy")
+	}
+	*l.TakeIf(func(*int) bool { return true }).Unwrap("") = 5
+	if y != 3 {
+		t.Error("TakeIf did not return a copy")
+	}
+}
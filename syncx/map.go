@@ -0,0 +1,73 @@
+package syncx
+
+import (
+	"sync"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+)
+
+// Map is a typed wrapper of `sync.Map` whose lookups return Options.
+// The zero value is empty and ready for use.
+type Map[K comparable, V any] struct {
+	m sync.Map
+}
+
+func some[V any](v any) *option.Option[V] {
+	x := v.(V)
+	return option.Some(&x)
+}
+
+// Load returns the value stored for the key, or [`None`] if no value is present.
+func (m *Map[K, V]) Load(k K) *option.Option[V] {
+	v, ok := m.m.Load(k)
+	if !ok {
+		return option.None[V]()
+	}
+	return some[V](v)
+}
+
+// Store sets the value for a key.
+func (m *Map[K, V]) Store(k K, v V) {
+	m.m.Store(k, v)
+}
+
+// LoadOrStore returns the existing value for the key if present.
+// Otherwise, it stores `v` and returns [`None`].
+func (m *Map[K, V]) LoadOrStore(k K, v V) *option.Option[V] {
+	actual, loaded := m.m.LoadOrStore(k, v)
+	if !loaded {
+		return option.None[V]()
+	}
+	return some[V](actual)
+}
+
+// Swap stores the value for a key and returns the previous value if any.
+func (m *Map[K, V]) Swap(k K, v V) *option.Option[V] {
+	previous, loaded := m.m.Swap(k, v)
+	if !loaded {
+		return option.None[V]()
+	}
+	return some[V](previous)
+}
+
+// Pop deletes the value for a key, returning the previous value if any.
+func (m *Map[K, V]) Pop(k K) *option.Option[V] {
+	v, loaded := m.m.LoadAndDelete(k)
+	if !loaded {
+		return option.None[V]()
+	}
+	return some[V](v)
+}
+
+// Delete deletes the value for a key.
+func (m *Map[K, V]) Delete(k K) {
+	m.m.Delete(k)
+}
+
+// Range calls `f` sequentially for each key and value present in the map.
+// If `f` returns false, range stops the iteration.
+func (m *Map[K, V]) Range(f func(K, V) bool) {
+	m.m.Range(func(k, v any) bool {
+		return f(k.(K), v.(V))
+	})
+}
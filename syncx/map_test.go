@@ -0,0 +1,19 @@
+package syncx
+
+import "testing"
+
+func TestMap(t *testing.T) {
+	var m Map[string, int]
+	if !m.Load("a").IsNone() {
+		t.Error("Load failed")
+	}
+	if !m.LoadOrStore("a", 1).IsNone() {
+		t.Error("LoadOrStore failed")
+	}
+	if v := m.LoadOrStore("a", 2); *v.Unwrap("") != 1 {
+		t.Error("LoadOrStore failed")
+	}
+	if v := m.Pop("a"); *v.Unwrap("") != 1 || !m.Load("a").IsNone() {
+		t.Error("Pop failed")
+	}
+}
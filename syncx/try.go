@@ -0,0 +1,72 @@
+package syncx
+
+import (
+	"sync"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+)
+
+// Unlocker releases a lock obtained by TryLock or TryRLock.
+type Unlocker func()
+
+// Release gives back the tokens obtained by `Semaphore.TryAcquire`.
+type Release func()
+
+// TryLocker is a `sync.Locker` supporting non-blocking acquisition, e.g. `*sync.Mutex`.
+type TryLocker interface {
+	sync.Locker
+	TryLock() bool
+}
+
+// TryLock tries to lock `mu` without blocking.
+// Returns [`Some`] with the function unlocking `mu` if it succeeded, otherwise [`None`].
+func TryLock(mu TryLocker) *option.Option[Unlocker] {
+	if !mu.TryLock() {
+		return option.None[Unlocker]()
+	}
+	unlock := Unlocker(sync.OnceFunc(mu.Unlock))
+	return option.Some(&unlock)
+}
+
+// TryRLock tries to lock `mu` for reading without blocking.
+// Returns [`Some`] with the function unlocking `mu` if it succeeded, otherwise [`None`].
+func TryRLock(mu *sync.RWMutex) *option.Option[Unlocker] {
+	if !mu.TryRLock() {
+		return option.None[Unlocker]()
+	}
+	unlock := Unlocker(sync.OnceFunc(mu.RUnlock))
+	return option.Some(&unlock)
+}
+
+// Semaphore is a counting semaphore with a fixed number of tokens.
+type Semaphore struct {
+	mu   sync.Mutex
+	size int64
+	cur  int64
+}
+
+// NewSemaphore creates a semaphore holding `size` tokens.
+func NewSemaphore(size int64) *Semaphore {
+	return &Semaphore{size: size}
+}
+
+// TryAcquire tries to acquire `n` tokens without blocking.
+// Returns [`Some`] with the function giving the tokens back if it succeeded, otherwise [`None`],
+// which is also returned for a non-positive `n`.
+func (s *Semaphore) TryAcquire(n int64) *option.Option[Release] {
+	if n <= 0 {
+		return option.None[Release]()
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.cur+n > s.size {
+		return option.None[Release]()
+	}
+	s.cur += n
+	release := Release(sync.OnceFunc(func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		s.cur -= n
+	}))
+	return option.Some(&release)
+}
@@ -0,0 +1,40 @@
+package syncx
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestTryLock(t *testing.T) {
+	var mu sync.Mutex
+	unlock := TryLock(&mu)
+	if unlock.IsNone() || TryLock(&mu).IsSome() {
+		t.Error("TryLock failed")
+	}
+	(*unlock.Unwrap(""))()
+	if TryLock(&mu).IsNone() {
+		t.Error("TryLock failed")
+	}
+}
+
+func TestTryAcquire(t *testing.T) {
+	s := NewSemaphore(2)
+	release := s.TryAcquire(2)
+	if release.IsNone() || s.TryAcquire(1).IsSome() {
+		t.Error("TryAcquire failed")
+	}
+	(*release.Unwrap(""))()
+	if s.TryAcquire(1).IsNone() {
+		t.Error("TryAcquire failed")
+	}
+}
+
+func TestTryAcquireNonPositive(t *testing.T) {
+	s := NewSemaphore(1)
+	if s.TryAcquire(-1).IsSome() || s.TryAcquire(0).IsSome() {
+		t.Error("TryAcquire accepted a non-positive count")
+	}
+	if s.TryAcquire(1).IsNone() || s.TryAcquire(1).IsSome() {
+		t.Error("TryAcquire changed the capacity")
+	}
+}
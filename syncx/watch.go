@@ -0,0 +1,76 @@
+package syncx
+
+import (
+	"context"
+	"sync"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+)
+
+// WatchableOption is an `Option[T]` that notifies subscribers of every change.
+// Every access is serialized by a mutex. The zero value is a [`None`] without subscribers.
+type WatchableOption[T any] struct {
+	mu   sync.Mutex
+	o    option.Option[T]
+	subs map[chan *option.Option[T]]struct{}
+}
+
+// Subscribe returns a channel that first receives the current option and then the new option after every change,
+// each subscriber receiving its own copy of the value.
+// A subscriber that falls behind only sees the latest option; intermediate ones are dropped.
+// The channel is closed once `ctx` is done.
+func (w *WatchableOption[T]) Subscribe(ctx context.Context) <-chan *option.Option[T] {
+	ch := make(chan *option.Option[T], 1)
+	w.mu.Lock()
+	if w.subs == nil {
+		w.subs = make(map[chan *option.Option[T]]struct{})
+	}
+	w.subs[ch] = struct{}{}
+	ch <- copied(w.o.UnwrapOrDefault())
+	w.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		w.mu.Lock()
+		defer w.mu.Unlock()
+		delete(w.subs, ch)
+		close(ch)
+	}()
+	return ch
+}
+
+// notify must be called with the lock held.
+func (w *WatchableOption[T]) notify() {
+	for ch := range w.subs {
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- copied(w.o.UnwrapOrDefault())
+	}
+}
+
+// Get returns a copy of the option.
+func (w *WatchableOption[T]) Get() *option.Option[T] {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return copied(w.o.UnwrapOrDefault())
+}
+
+// Replace replaces the value, returning a copy of the old value if present, and notifies subscribers.
+func (w *WatchableOption[T]) Replace(v *T) *option.Option[T] {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	old := copied(w.o.Replace(v))
+	w.notify()
+	return old
+}
+
+// Take takes a copy of the value out, leaving a [`None`] in its place, and notifies subscribers.
+func (w *WatchableOption[T]) Take() *option.Option[T] {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	old := copied(w.o.Take())
+	w.notify()
+	return old
+}
@@ -0,0 +1,57 @@
+package syncx
+
+import (
+	"context"
+	"testing"
+)
+
+func TestWatchableOption(t *testing.T) {
+	var w WatchableOption[string]
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := w.Subscribe(ctx)
+	if !(<-ch).IsNone() {
+		t.Error("replay of None failed")
+	}
+
+	a, b := "a", "b"
+	w.Replace(&a)
+	if *(<-ch).Unwrap("") != "a" {
+		t.Error("Replace was not notified")
+	}
+
+	w.Replace(&b)
+	late := w.Subscribe(context.Background())
+	if *(<-late).Unwrap("") != "b" {
+		t.Error("replay of current value failed")
+	}
+
+	if old := w.Take(); *old.Unwrap("") != "b" || !w.Get().IsNone() {
+		t.Error("Take failed")
+	}
+	if !(<-ch).IsNone() {
+		t.Error("slow subscriber did not get the latest value")
+	}
+
+	cancel()
+	for range ch {
+	}
+	w.Replace(&a)
+	if *(<-late).Unwrap("") != "a" {
+		t.Error("remaining subscriber was not notified")
+	}
+}
+
+func TestWatchableOptionCopies(t *testing.T) {
+	var w WatchableOption[string]
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	a, b := w.Subscribe(ctx), w.Subscribe(ctx)
+	<-a
+	<-b
+	s := "x"
+	w.Replace(&s)
+	*(<-a).Unwrap("") = "y"
+	if *(<-b).Unwrap("") != "x" || *w.Get().Unwrap("") != "x" || s != "x" {
+		t.Error("subscribers share the value")
+	}
+}
@@ -0,0 +1,38 @@
+//go:build go1.24
+
+package syncx
+
+import (
+	"sync"
+	"weak"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+)
+
+// WeakOption is an `Option[T]` that holds its value through a weak pointer,
+// so it does not keep the value alive. Once the value has been garbage collected it reads as [`None`].
+// Every access is serialized by a mutex. The zero value is a [`None`].
+type WeakOption[T any] struct {
+	mu sync.Mutex
+	p  weak.Pointer[T]
+}
+
+// Set replaces the value with a weak reference to `v`. A nil `v` clears the option.
+func (w *WeakOption[T]) Set(v *T) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.p = weak.Make(v)
+}
+
+// Get returns a strong pointer to the value, or [`None`] if it was never set, cleared or collected.
+// The returned option keeps the value alive for as long as it is held.
+func (w *WeakOption[T]) Get() *option.Option[T] {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return option.New(w.p.Value())
+}
+
+// Clear removes the value.
+func (w *WeakOption[T]) Clear() {
+	w.Set(nil)
+}
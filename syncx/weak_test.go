@@ -0,0 +1,47 @@
+//go:build go1.24
+
+package syncx
+
+import (
+	"runtime"
+	"testing"
+)
+
+type weakPayload struct {
+	name string
+	buf  [256]byte
+}
+
+func TestWeakOption(t *testing.T) {
+	var w WeakOption[weakPayload]
+	if !w.Get().IsNone() {
+		t.Error("zero value is not None")
+	}
+
+	v := &weakPayload{name: "kept"}
+	w.Set(v)
+	runtime.GC()
+	if w.Get().Unwrap("").name != "kept" {
+		t.Error("live value was lost")
+	}
+	runtime.KeepAlive(v)
+
+	w.Set(&weakPayload{name: "dropped"})
+	for range 10 {
+		runtime.GC()
+		if w.Get().IsNone() {
+			break
+		}
+	}
+	if !w.Get().IsNone() {
+		t.Error("collected value is still Some")
+	}
+
+	v = &weakPayload{}
+	w.Set(v)
+	w.Clear()
+	if !w.Get().IsNone() {
+		t.Error("Clear failed")
+	}
+	runtime.KeepAlive(v)
+}
@@ -0,0 +1,82 @@
+package templatex
+
+import "reflect"
+
+// FuncMap returns functions for templates rendering options and results,
+// to be passed to the `Funcs` method of text/template or html/template:
+//
+//	isSome, isNone: whether an option is [`Some`] or [`None`]
+//	isOk, isErr:    whether a result is [`Ok`] or [`Err`]
+//	unwrap:         the contained value, or nil
+//	unwrapOr:       the contained value, or the fallback
+//	errOf:          the error of an [`Err`], or nil
+//
+// Options and results may be passed either as values or as pointers.
+func FuncMap() map[string]any {
+	return map[string]any{
+		"isSome": func(v any) bool { return call[bool](v, "IsSome") },
+		"isNone": func(v any) bool { return call[bool](v, "IsNone") },
+		"isOk":   func(v any) bool { return call[bool](v, "IsOk") },
+		"isErr":  func(v any) bool { return call[bool](v, "IsErr") },
+		"unwrap": func(v any) any {
+			value, _ := unwrap(v)
+			return value
+		},
+		"unwrapOr": func(v any, fallback any) any {
+			if value, ok := unwrap(v); ok {
+				return value
+			}
+			return fallback
+		},
+		"errOf": func(v any) error {
+			if !call[bool](v, "IsErr") {
+				return nil
+			}
+			return call[error](v, "UnwrapError")
+		},
+	}
+}
+
+// method returns the named method of `v`, taking the address of a copy of `v`
+// if it is only defined on the pointer type.
+func method(v any, name string) reflect.Value {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return reflect.Value{}
+	}
+	if m := rv.MethodByName(name); m.IsValid() {
+		return m
+	}
+	p := reflect.New(rv.Type())
+	p.Elem().Set(rv)
+	return p.MethodByName(name)
+}
+
+func call[R any](v any, name string) R {
+	var zero R
+	m := method(v, name)
+	if !m.IsValid() || m.Type().NumIn() != 0 || m.Type().NumOut() != 1 {
+		return zero
+	}
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return zero
+	}
+	r, _ := m.Call(nil)[0].Interface().(R)
+	return r
+}
+
+// unwrap returns the value of an option or a result holding one.
+func unwrap(v any) (any, bool) {
+	m := method(v, "UnwrapOrDefault")
+	if !m.IsValid() || m.Type().NumIn() != 0 || m.Type().NumOut() != 1 {
+		return nil, false
+	}
+	if rv := reflect.ValueOf(v); rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return nil, false
+	}
+	p := m.Call(nil)[0]
+	if p.Kind() != reflect.Pointer || p.IsNil() {
+		return nil, false
+	}
+	return p.Elem().Interface(), true
+}
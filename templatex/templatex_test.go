@@ -0,0 +1,35 @@
+package templatex
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"text/template"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+func TestFuncMap(t *testing.T) {
+	tmpl := template.Must(template.New("").Funcs(FuncMap()).Parse(
+		`{{if isSome .Nick}}{{unwrap .Nick}}{{else}}-{{end}} {{unwrapOr .Age 0}} {{if isErr .Load}}{{errOf .Load}}{{end}}`))
+
+	nick := "al"
+	data := struct {
+		Nick option.Option[string]
+		Age  *option.Option[int]
+		Load *result.Result[int]
+	}{
+		Nick: *option.Some(&nick),
+		Age:  option.None[int](),
+		Load: result.Err[int](errors.New("boom")),
+	}
+
+	var sb strings.Builder
+	if err := tmpl.Execute(&sb, data); err != nil {
+		t.Fatal(err)
+	}
+	if sb.String() != "al 0 boom" {
+		t.Errorf("FuncMap failed: %q", sb.String())
+	}
+}
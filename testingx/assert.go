@@ -0,0 +1,78 @@
+package testingx
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// Ok returns the value of an [`Ok`], failing the test immediately for an [`Err`].
+func Ok[T any](t testing.TB, r *result.Result[T]) *T {
+	t.Helper()
+	if r.IsErr() {
+		t.Fatalf("got Err(%v), want Ok", r.UnwrapError())
+	}
+	return r.Unwrap()
+}
+
+// OkEq checks that the result is an [`Ok`] of a value equal to `want`, as compared
+// by `cmp.Diff` with the options, failing the test immediately otherwise.
+// A nil [`Ok`] value is compared as the zero value.
+func OkEq[T any](t testing.TB, r *result.Result[T], want T, opts ...cmp.Option) {
+	t.Helper()
+	got := valueOf(Ok(t, r))
+	if diff := cmp.Diff(want, got, opts...); diff != "" {
+		t.Fatalf("Ok value mismatch (-want +got):\n%s", diff)
+	}
+}
+
+// ErrIs checks that the result is an [`Err`] matching `target` with errors.Is,
+// failing the test immediately otherwise.
+func ErrIs[T any](t testing.TB, r *result.Result[T], target error) {
+	t.Helper()
+	if r.IsOk() {
+		t.Fatalf("got Ok(%+v), want Err matching %v", valueOf(r.Unwrap()), target)
+	}
+	if err := r.UnwrapError(); !errors.Is(err, target) {
+		t.Fatalf("got Err(%v), want Err matching %v", err, target)
+	}
+}
+
+// Some returns the value of a [`Some`], failing the test immediately for a [`None`].
+func Some[T any](t testing.TB, o *option.Option[T]) *T {
+	t.Helper()
+	if o.IsNone() {
+		t.Fatalf("got None, want Some")
+	}
+	return o.Unwrap("")
+}
+
+// SomeEq checks that the option is a [`Some`] of a value equal to `want`, as compared
+// by `cmp.Diff` with the options, failing the test immediately otherwise.
+func SomeEq[T any](t testing.TB, o *option.Option[T], want T, opts ...cmp.Option) {
+	t.Helper()
+	got := *Some(t, o)
+	if diff := cmp.Diff(want, got, opts...); diff != "" {
+		t.Fatalf("Some value mismatch (-want +got):\n%s", diff)
+	}
+}
+
+// None checks that the option is a [`None`], failing the test immediately otherwise.
+func None[T any](t testing.TB, o *option.Option[T]) {
+	t.Helper()
+	if o.IsSome() {
+		t.Fatalf("got Some(%+v), want None", *o.Unwrap(""))
+	}
+}
+
+func valueOf[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return *v
+}
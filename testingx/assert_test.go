@@ -0,0 +1,81 @@
+package testingx
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// fakeT records the failure of a helper, stopping it like t.Fatalf does.
+type fakeT struct {
+	testing.TB
+	msg string
+}
+
+func (t *fakeT) Helper() {}
+
+func (t *fakeT) Fatalf(format string, args ...any) {
+	t.msg = fmt.Sprintf(format, args...)
+	panic(t)
+}
+
+// failure returns the failure message of `f`, empty if it passed.
+func failure(f func(t testing.TB)) (msg string) {
+	ft := &fakeT{}
+	defer func() {
+		if v := recover(); v != nil && v != any(ft) {
+			panic(v)
+		}
+		msg = ft.msg
+	}()
+	f(ft)
+	return ""
+}
+
+type user struct {
+	Name string
+	Age  int
+}
+
+func TestOkEq(t *testing.T) {
+	u := user{"ann", 30}
+	OkEq(t, result.Ok(&u), user{"ann", 30})
+
+	msg := failure(func(t testing.TB) { OkEq(t, result.Ok(&u), user{"ann", 31}) })
+	if !strings.Contains(msg, "(-want +got)") || !strings.Contains(msg, "31") {
+		t.Errorf("OkEq failed: %s", msg)
+	}
+	if msg := failure(func(t testing.TB) { OkEq(t, result.Err[user](io.EOF), u) }); msg != "got Err(EOF), want Ok" {
+		t.Errorf("OkEq failed: %s", msg)
+	}
+}
+
+func TestErrIs(t *testing.T) {
+	ErrIs(t, result.Err[int](fmt.Errorf("reading: %w", io.EOF)), io.EOF)
+
+	if msg := failure(func(t testing.TB) { ErrIs(t, result.Err[int](errors.New("boom")), io.EOF) }); msg != "got Err(boom), want Err matching EOF" {
+		t.Errorf("ErrIs failed: %s", msg)
+	}
+	x := 1
+	if msg := failure(func(t testing.TB) { ErrIs(t, result.Ok(&x), io.EOF) }); msg != "got Ok(1), want Err matching EOF" {
+		t.Errorf("ErrIs failed: %s", msg)
+	}
+}
+
+func TestSomeEq(t *testing.T) {
+	x := 1
+	SomeEq(t, option.Some(&x), 1)
+	None(t, option.None[int]())
+
+	if msg := failure(func(t testing.TB) { SomeEq(t, option.None[int](), 1) }); msg != "got None, want Some" {
+		t.Errorf("SomeEq failed: %s", msg)
+	}
+	if msg := failure(func(t testing.TB) { None(t, option.Some(&x)) }); msg != "got Some(1), want None" {
+		t.Errorf("None failed: %s", msg)
+	}
+}
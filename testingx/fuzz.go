@@ -0,0 +1,105 @@
+package testingx
+
+import (
+	"bufio"
+	"bytes"
+	"encoding"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+const corpusHeader = "go test fuzz v1"
+
+// EncodeCorpus returns the content of a `go test -fuzz` corpus file holding the binary
+// encodings of the values, one `[]byte` argument each, e.g. options and results.
+func EncodeCorpus(vs ...encoding.BinaryMarshaler) ([]byte, error) {
+	var b bytes.Buffer
+	b.WriteString(corpusHeader + "\n")
+	for _, v := range vs {
+		data, err := v.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(&b, "[]byte(%q)\n", data)
+	}
+	return b.Bytes(), nil
+}
+
+// DecodeCorpus returns the `[]byte` arguments of a corpus file content.
+func DecodeCorpus(content []byte) ([][]byte, error) {
+	sc := bufio.NewScanner(bytes.NewReader(content))
+	if !sc.Scan() || sc.Text() != corpusHeader {
+		return nil, fmt.Errorf("testingx: missing %q header", corpusHeader)
+	}
+	var args [][]byte
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" {
+			continue
+		}
+		quoted, ok := strings.CutPrefix(line, "[]byte(")
+		if !ok || !strings.HasSuffix(quoted, ")") {
+			return nil, fmt.Errorf("testingx: unsupported corpus argument %s", line)
+		}
+		s, err := strconv.Unquote(quoted[:len(quoted)-1])
+		if err != nil {
+			return nil, fmt.Errorf("testingx: corpus argument %s: %w", line, err)
+		}
+		args = append(args, []byte(s))
+	}
+	return args, sc.Err()
+}
+
+// WriteCorpus writes the values as the corpus entry `name` of the fuzz test `fuzzTest`,
+// in `testdata/fuzz/<fuzzTest>/<name>`.
+func WriteCorpus(fuzzTest, name string, vs ...encoding.BinaryMarshaler) error {
+	content, err := EncodeCorpus(vs...)
+	if err != nil {
+		return err
+	}
+	dir := filepath.Join("testdata", "fuzz", fuzzTest)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(dir, name), content, 0o644)
+}
+
+// AddSeed adds the binary encodings of the values as a seed of the fuzz test, failing it
+// if they cannot be encoded.
+func AddSeed(f *testing.F, vs ...encoding.BinaryMarshaler) {
+	f.Helper()
+	args := make([]any, len(vs))
+	for i, v := range vs {
+		data, err := v.MarshalBinary()
+		if err != nil {
+			f.Fatalf("encoding seed: %v", err)
+		}
+		args[i] = data
+	}
+	f.Add(args...)
+}
+
+// FuzzOption decodes a fuzz input into an option, reporting false if it isn't a valid encoding.
+func FuzzOption[T any](data []byte) (*option.Option[T], bool) {
+	var o option.Option[T]
+	if err := o.UnmarshalBinary(data); err != nil {
+		return nil, false
+	}
+	return &o, true
+}
+
+// FuzzResult decodes a fuzz input into a result, reporting false if it isn't a valid encoding.
+func FuzzResult[T any](data []byte) (*result.Result[T], bool) {
+	var r result.Result[T]
+	if err := r.UnmarshalBinary(data); err != nil {
+		return nil, false
+	}
+	return &r, true
+}
@@ -0,0 +1,57 @@
+package testingx
+
+import (
+	"bytes"
+	"encoding"
+	"errors"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+func TestCorpus(t *testing.T) {
+	x := int32(7)
+	content, err := EncodeCorpus(option.Some(&x), result.Err[int32](errors.New("boom")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != "go test fuzz v1\n[]byte(\"\\x01\\x00\\x00\\x00\\a\")\n[]byte(\"\\x00boom\")\n" {
+		t.Errorf("EncodeCorpus failed: %q", content)
+	}
+
+	args, err := DecodeCorpus(content)
+	if err != nil || len(args) != 2 || !bytes.Equal(args[1], []byte("\x00boom")) {
+		t.Errorf("DecodeCorpus failed: %q %v", args, err)
+	}
+	if _, err := DecodeCorpus([]byte("go test fuzz v1\nint(1)\n")); err == nil {
+		t.Error("DecodeCorpus accepted a non-[]byte argument")
+	}
+
+	o, ok := FuzzOption[int32](args[0])
+	SomeEq(t, o, 7)
+	r, ok2 := FuzzResult[int32](args[1])
+	if !ok || !ok2 || r.UnwrapError().Error() != "boom" {
+		t.Error("FuzzResult failed")
+	}
+	if _, ok := FuzzOption[int32]([]byte{1, 2}); ok {
+		t.Error("FuzzOption accepted an invalid input")
+	}
+}
+
+func FuzzOptionRoundTrip(f *testing.F) {
+	x := int64(42)
+	AddSeed(f, option.Some(&x))
+	AddSeed(f, option.None[int64]())
+	f.Fuzz(func(t *testing.T, data []byte) {
+		o, ok := FuzzOption[int64](data)
+		if !ok {
+			t.Skip()
+		}
+		var m encoding.BinaryMarshaler = o
+		b, err := m.MarshalBinary()
+		if err != nil || !bytes.Equal(b, data) {
+			t.Errorf("round trip of %q gave %q", data, b)
+		}
+	})
+}
@@ -0,0 +1,162 @@
+package testingx
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// Gen generates random values from a source of randomness.
+type Gen[T any] func(r *rand.Rand) T
+
+// Shrink returns candidates simpler than a value, simplest first, used to minimize
+// the counterexamples of a property. It is the integration point for shrinking libraries.
+type Shrink[T any] func(v T) []T
+
+type genConfig struct {
+	noneProb float64
+	errProb  float64
+	errs     []error
+}
+
+// GenOpt configures GenOption and GenResult.
+type GenOpt func(*genConfig)
+
+// WithNoneProb sets the probability of generating a [`None`], 0.25 by default.
+func WithNoneProb(p float64) GenOpt {
+	return func(c *genConfig) {
+		c.noneProb = p
+	}
+}
+
+// WithErrProb sets the probability of generating an [`Err`], 0.25 by default.
+func WithErrProb(p float64) GenOpt {
+	return func(c *genConfig) {
+		c.errProb = p
+	}
+}
+
+// WithErrors sets the errors an [`Err`] is picked from, a generic error by default.
+func WithErrors(errs ...error) GenOpt {
+	return func(c *genConfig) {
+		c.errs = errs
+	}
+}
+
+// ErrGenerated is the default error of the results generated by GenResult.
+var ErrGenerated = errors.New("testingx: generated error")
+
+func newGenConfig(opts []GenOpt) *genConfig {
+	c := &genConfig{noneProb: 0.25, errProb: 0.25, errs: []error{ErrGenerated}}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// GenOption returns a generator of options, [`Some`] of values generated by `gen`
+// or [`None`] with the probability set by WithNoneProb.
+func GenOption[T any](gen Gen[T], opts ...GenOpt) Gen[*option.Option[T]] {
+	c := newGenConfig(opts)
+	return func(r *rand.Rand) *option.Option[T] {
+		if r.Float64() < c.noneProb {
+			return option.None[T]()
+		}
+		v := gen(r)
+		return option.Some(&v)
+	}
+}
+
+// GenResult returns a generator of results, [`Ok`] of values generated by `gen`
+// or [`Err`] with the probability and errors set by WithErrProb and WithErrors.
+func GenResult[T any](gen Gen[T], opts ...GenOpt) Gen[*result.Result[T]] {
+	c := newGenConfig(opts)
+	return func(r *rand.Rand) *result.Result[T] {
+		if r.Float64() < c.errProb {
+			return result.Err[T](c.errs[r.IntN(len(c.errs))])
+		}
+		v := gen(r)
+		return result.Ok(&v)
+	}
+}
+
+// ShrinkOption returns a shrinker of options: a [`Some`] shrinks to [`None`], then to
+// [`Some`] of the shrunk values. `shrink` may be nil.
+func ShrinkOption[T any](shrink Shrink[T]) Shrink[*option.Option[T]] {
+	return func(o *option.Option[T]) []*option.Option[T] {
+		if o.IsNone() {
+			return nil
+		}
+		candidates := []*option.Option[T]{option.None[T]()}
+		if shrink != nil {
+			for _, v := range shrink(*o.Unwrap("")) {
+				candidates = append(candidates, option.Some(&v))
+			}
+		}
+		return candidates
+	}
+}
+
+// ShrinkResult returns a shrinker of results: an [`Ok`] shrinks to [`Ok`] of the shrunk values,
+// an [`Err`] doesn't shrink. `shrink` may be nil.
+func ShrinkResult[T any](shrink Shrink[T]) Shrink[*result.Result[T]] {
+	return func(r *result.Result[T]) []*result.Result[T] {
+		if r.IsErr() || shrink == nil || !r.IsOkAndNotNil() {
+			return nil
+		}
+		var candidates []*result.Result[T]
+		for _, v := range shrink(*r.Unwrap()) {
+			candidates = append(candidates, result.Ok(&v))
+		}
+		return candidates
+	}
+}
+
+// Check checks the property on `n` values generated by `gen` from a deterministic seed,
+// failing the test with the simplest counterexample found by `shrink`, which may be nil.
+func Check[T any](t testing.TB, n int, seed uint64, gen Gen[T], shrink Shrink[T], prop func(T) bool) {
+	t.Helper()
+	r := rand.New(rand.NewPCG(seed, seed))
+	for i := range n {
+		v := gen(r)
+		if prop(v) {
+			continue
+		}
+		v = minimize(v, shrink, prop)
+		t.Fatalf("property failed on value %d of seed %d: %s", i, seed, describe(v))
+	}
+}
+
+// minimize shrinks a counterexample while the property keeps failing.
+func minimize[T any](v T, shrink Shrink[T], prop func(T) bool) T {
+	if shrink == nil {
+		return v
+	}
+	for steps := 0; steps < 1000; steps++ {
+		shrunk := false
+		for _, c := range shrink(v) {
+			if !prop(c) {
+				v, shrunk = c, true
+				break
+			}
+		}
+		if !shrunk {
+			break
+		}
+	}
+	return v
+}
+
+// describe formats a value the way Golden serializes it.
+func describe(v any) string {
+	b, err := json.Marshal(serializable(v))
+	if err != nil {
+		return fmt.Sprintf("%+v", v)
+	}
+	return string(b)
+}
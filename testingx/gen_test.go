@@ -0,0 +1,55 @@
+package testingx
+
+import (
+	"errors"
+	"math/rand/v2"
+	"strings"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+func genInt(r *rand.Rand) int { return r.IntN(1000) }
+
+func shrinkInt(v int) []int {
+	if v == 0 {
+		return nil
+	}
+	return []int{0, v / 2, v - 1}
+}
+
+func TestGenResult(t *testing.T) {
+	boom := errors.New("boom")
+	gen := GenResult(genInt, WithErrProb(0.5), WithErrors(boom))
+	var oks, errs int
+	r := rand.New(rand.NewPCG(1, 1))
+	for range 1000 {
+		if res := gen(r); res.IsOk() {
+			oks++
+		} else if res.UnwrapError() == boom {
+			errs++
+		}
+	}
+	if oks+errs != 1000 || errs < 400 || errs > 600 {
+		t.Errorf("GenResult failed: %d oks, %d errs", oks, errs)
+	}
+
+	// Map preserves Err.
+	Check(t, 200, 1, gen, ShrinkResult(shrinkInt), func(r *result.Result[int]) bool {
+		m := result.Map(*r, func(x *int) *int { y := *x + 1; return &y })
+		return r.IsOk() == m.IsOk()
+	})
+}
+
+func TestCheckShrinks(t *testing.T) {
+	gen := GenOption(genInt, WithNoneProb(0))
+	msg := failure(func(t testing.TB) {
+		Check(t, 100, 1, gen, ShrinkOption(shrinkInt), func(o *option.Option[int]) bool {
+			return o.IsNone() || *o.Unwrap("") < 10
+		})
+	})
+	if !strings.HasSuffix(msg, ": 10") {
+		t.Errorf("Check failed to shrink: %s", msg)
+	}
+}
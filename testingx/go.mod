@@ -0,0 +1,10 @@
+module github.com/yuanzicheng/go-result-and-option/testingx
+
+go 1.25.0
+
+require (
+	github.com/google/go-cmp v0.7.0
+	github.com/yuanzicheng/go-result-and-option v0.0.0
+)
+
+replace github.com/yuanzicheng/go-result-and-option => ../
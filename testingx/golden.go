@@ -0,0 +1,87 @@
+package testingx
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/internal/peek"
+)
+
+var update = flag.Bool("update", false, "update the golden files of testingx.Golden")
+
+// Golden compares the JSON serialization of `v` with the golden file `testdata/<name>.golden`,
+// failing the test if they differ. With the `-update` flag, the golden file is written instead.
+//
+// Results are serialized as `{"ok": value}` or `{"err": "message"}`, and options as
+// their value or null, including in slices and maps with string keys.
+// Other values are serialized by encoding/json.
+func Golden(t testing.TB, name string, v any) {
+	t.Helper()
+	got, err := json.MarshalIndent(serializable(v), "", "  ")
+	if err != nil {
+		t.Fatalf("serializing %s: %v", name, err)
+	}
+	got = append(got, '\n')
+
+	path := filepath.Join("testdata", name+".golden")
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, got, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading golden file, run with -update to create it: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Errorf("%s doesn't match %s, run with -update to update it:\ngot:\n%s\nwant:\n%s", name, path, got, want)
+	}
+}
+
+// serializable returns a value serializing results and options as documented by Golden.
+func serializable(v any) any {
+	if _, ok := v.(json.Marshaler); ok {
+		return v
+	}
+	rv := reflect.ValueOf(v)
+	switch {
+	case isGeneric(rv, "result", "Result["):
+		value, err := peek.Result(v)
+		if err != nil {
+			return map[string]any{"err": err.Error()}
+		}
+		return map[string]any{"ok": serializable(value)}
+	case isGeneric(rv, "option", "Option["):
+		return serializable(peek.Option(v))
+	case rv.Kind() == reflect.Slice || rv.Kind() == reflect.Array:
+		s := make([]any, rv.Len())
+		for i := range s {
+			s[i] = serializable(rv.Index(i).Interface())
+		}
+		return s
+	case rv.Kind() == reflect.Map && rv.Type().Key().Kind() == reflect.String:
+		m := make(map[string]any, rv.Len())
+		for it := rv.MapRange(); it.Next(); {
+			m[it.Key().String()] = serializable(it.Value().Interface())
+		}
+		return m
+	}
+	return v
+}
+
+func isGeneric(rv reflect.Value, pkg, prefix string) bool {
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return false
+	}
+	t := rv.Type().Elem()
+	return t.PkgPath() == "github.com/yuanzicheng/go-result-and-option/"+pkg && len(t.Name()) > len(prefix) && t.Name()[:len(prefix)] == prefix
+}
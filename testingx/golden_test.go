@@ -0,0 +1,28 @@
+package testingx
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+func TestGolden(t *testing.T) {
+	u := user{"ann", 30}
+	name := "bob"
+	Golden(t, "pipeline", []any{
+		result.Ok(&u),
+		result.Err[user](errors.New("no such user")),
+		option.Some(&name),
+		option.None[string](),
+		result.Ok(option.Some(&name)),
+	})
+
+	if *update {
+		return
+	}
+	if msg := failure(func(t testing.TB) { Golden(t, "missing", 1) }); msg == "" {
+		t.Error("Golden passed without golden file")
+	}
+}
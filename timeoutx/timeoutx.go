@@ -0,0 +1,40 @@
+package timeoutx
+
+import (
+	"context"
+	"time"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// With runs `f` in a goroutine with a context bounded by `d`.
+// Returns the result of `f` if it finishes in time, otherwise returns an [`Err`]
+// holding the context error (`context.DeadlineExceeded` on timeout).
+func With[T any](ctx context.Context, d time.Duration, f func(context.Context) *result.Result[T]) *result.Result[T] {
+	return WithLate(ctx, d, f, nil)
+}
+
+// WithLate is like With, but calls `late` with the result of `f` if it arrives
+// after the timeout has fired, so late values can be released or logged.
+// The late result is always drained, the goroutine running `f` never leaks.
+func WithLate[T any](ctx context.Context, d time.Duration, f func(context.Context) *result.Result[T], late func(*result.Result[T])) *result.Result[T] {
+	ctx, cancel := context.WithTimeout(ctx, d)
+	ch := make(chan *result.Result[T], 1)
+	go func() {
+		ch <- f(ctx)
+	}()
+
+	select {
+	case r := <-ch:
+		cancel()
+		return r
+	case <-ctx.Done():
+		cancel()
+		if late != nil {
+			go func() {
+				late(<-ch)
+			}()
+		}
+		return result.Err[T](ctx.Err())
+	}
+}
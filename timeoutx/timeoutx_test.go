@@ -0,0 +1,37 @@
+package timeoutx
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+func TestWith(t *testing.T) {
+	x := 1
+	r := With(context.Background(), time.Second, func(ctx context.Context) *result.Result[int] {
+		return result.Ok(&x)
+	})
+	if !r.IsOk() || *r.Unwrap() != 1 {
+		t.Error("With failed")
+	}
+}
+
+func TestWithLate(t *testing.T) {
+	x := 1
+	done := make(chan *result.Result[int])
+	r := WithLate(context.Background(), time.Millisecond, func(ctx context.Context) *result.Result[int] {
+		<-ctx.Done()
+		return result.Ok(&x)
+	}, func(r *result.Result[int]) {
+		done <- r
+	})
+	if !errors.Is(r.UnwrapError(), context.DeadlineExceeded) {
+		t.Error("WithLate timeout failed")
+	}
+	if late := <-done; !late.IsOk() {
+		t.Error("WithLate hook failed")
+	}
+}
@@ -0,0 +1,25 @@
+package timex
+
+import (
+	"time"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// Parse parses a formatted time, as `time.Parse` does.
+func Parse(layout, s string) *result.Result[time.Time] {
+	t, err := time.Parse(layout, s)
+	return result.New(&t, err)
+}
+
+// ParseInLocation parses a formatted time in the given location, as `time.ParseInLocation` does.
+func ParseInLocation(layout, s string, loc *time.Location) *result.Result[time.Time] {
+	t, err := time.ParseInLocation(layout, s, loc)
+	return result.New(&t, err)
+}
+
+// ParseDuration parses a duration string, as `time.ParseDuration` does.
+func ParseDuration(s string) *result.Result[time.Duration] {
+	d, err := time.ParseDuration(s)
+	return result.New(&d, err)
+}
@@ -0,0 +1,18 @@
+package timex
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	if Parse(time.DateOnly, "2024-02-29").Unwrap().Day() != 29 || Parse(time.DateOnly, "2023-02-29").IsOk() {
+		t.Error("Parse failed")
+	}
+	if ParseInLocation(time.DateTime, "2024-01-01 00:00:00", time.UTC).Unwrap().Location() != time.UTC {
+		t.Error("ParseInLocation failed")
+	}
+	if *ParseDuration("1m30s").Unwrap() != 90*time.Second || ParseDuration("1x").IsOk() {
+		t.Error("ParseDuration failed")
+	}
+}
@@ -0,0 +1,37 @@
+// Package txx runs database transactions as functions returning results.
+package txx
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// Beginner starts transactions, e.g. `*sql.DB` or `*sql.Conn`.
+type Beginner interface {
+	BeginTx(ctx context.Context, opts *sql.TxOptions) (*sql.Tx, error)
+}
+
+// Run calls `fn` within a transaction, committing it if `fn` returns [`Ok`]
+// and rolling it back if `fn` returns [`Err`] or panics. A panic becomes an [`Err`] of `*result.PanicError`.
+// An error from beginning the transaction is returned without calling `fn`.
+// An error from Commit turns the result into an [`Err`], and one from Rollback is joined with the error of `fn`.
+func Run[T any](ctx context.Context, db Beginner, fn func(*sql.Tx) *result.Result[T]) *result.Result[T] {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return result.Err[T](err)
+	}
+	r := result.Catch(func() *result.Result[T] { return fn(tx) })
+	if r.IsErr() {
+		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+			return result.Err[T](errors.Join(r.UnwrapError(), err))
+		}
+		return r
+	}
+	if err := tx.Commit(); err != nil {
+		return result.Err[T](err)
+	}
+	return r
+}
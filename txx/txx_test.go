@@ -0,0 +1,95 @@
+package txx
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// fakeDriver records the transaction outcomes in `log`.
+// The name of the data source selects the failures: "begin", "commit" or "rollback".
+type fakeDriver struct{}
+
+type fakeConn struct{ fail string }
+
+type fakeTx struct{ fail string }
+
+var log []string
+
+func (fakeDriver) Open(name string) (driver.Conn, error) { return fakeConn{fail: name}, nil }
+
+func (fakeConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (fakeConn) Close() error                        { return nil }
+func (c fakeConn) Begin() (driver.Tx, error) {
+	if c.fail == "begin" {
+		return nil, errors.New("begin")
+	}
+	return fakeTx(c), nil
+}
+
+func (t fakeTx) Commit() error {
+	log = append(log, "commit")
+	if t.fail == "commit" {
+		return errors.New("commit")
+	}
+	return nil
+}
+
+func (t fakeTx) Rollback() error {
+	log = append(log, "rollback")
+	if t.fail == "rollback" {
+		return errors.New("rollback")
+	}
+	return nil
+}
+
+func init() {
+	sql.Register("txx-fake", fakeDriver{})
+}
+
+func run(t *testing.T, fail string, fn func(*sql.Tx) *result.Result[int]) (*result.Result[int], string) {
+	t.Helper()
+	db, err := sql.Open("txx-fake", fail)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	log = nil
+	r := Run(context.Background(), db, fn)
+	return r, strings.Join(log, ",")
+}
+
+func TestRun(t *testing.T) {
+	one := 1
+	boom := errors.New("boom")
+	ok := func(*sql.Tx) *result.Result[int] { return result.Ok(&one) }
+	fail := func(*sql.Tx) *result.Result[int] { return result.Err[int](boom) }
+
+	if r, l := run(t, "", ok); *r.Unwrap() != 1 || l != "commit" {
+		t.Errorf("Ok: %v, %s", r, l)
+	}
+	if r, l := run(t, "", fail); !errors.Is(r.UnwrapError(), boom) || l != "rollback" {
+		t.Errorf("Err: %v, %s", r, l)
+	}
+	r, l := run(t, "", func(*sql.Tx) *result.Result[int] { panic(boom) })
+	var pe *result.PanicError
+	if !errors.As(r.UnwrapError(), &pe) || !errors.Is(r.UnwrapError(), boom) || l != "rollback" {
+		t.Errorf("panic: %v, %s", r, l)
+	}
+
+	if r, l := run(t, "commit", ok); r.UnwrapError().Error() != "commit" || l != "commit" {
+		t.Errorf("commit failure: %v, %s", r, l)
+	}
+	if r, _ := run(t, "rollback", fail); !errors.Is(r.UnwrapError(), boom) || !strings.Contains(r.UnwrapError().Error(), "rollback") {
+		t.Errorf("rollback failure: %v", r)
+	}
+	called := false
+	if r, _ := run(t, "begin", func(*sql.Tx) *result.Result[int] { called = true; return nil }); called || r.UnwrapError().Error() != "begin" {
+		t.Errorf("begin failure: %v", r)
+	}
+}
@@ -0,0 +1,43 @@
+package urlx
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// Parse parses a raw URL, as `url.Parse` does.
+func Parse(s string) *result.Result[url.URL] {
+	u, err := url.Parse(s)
+	if err != nil {
+		return result.Err[url.URL](err)
+	}
+	return result.Ok(u)
+}
+
+// QueryGet returns the first value of the query parameter, or [`None`] if it is absent.
+// A parameter present without a value is a [`Some`] of the empty string.
+func QueryGet(u *url.URL, key string) *option.Option[string] {
+	vs, ok := u.Query()[key]
+	if !ok || len(vs) == 0 {
+		return option.None[string]()
+	}
+	return option.Some(&vs[0])
+}
+
+// QueryGetParsed parses the first value of the query parameter with `parse`.
+// Returns [`Ok`] of [`None`] if the parameter is absent, or an [`Err`] naming
+// the parameter if it can't be parsed.
+func QueryGetParsed[T any](u *url.URL, key string, parse func(string) *result.Result[T]) *result.Result[option.Option[T]] {
+	v := QueryGet(u, key)
+	if v.IsNone() {
+		return result.Ok(option.None[T]())
+	}
+	r := parse(*v.UnwrapOrDefault())
+	if r.IsErr() {
+		return result.Err[option.Option[T]](fmt.Errorf("parsing query parameter %s: %w", key, r.UnwrapError()))
+	}
+	return result.Ok(option.New(r.Unwrap()))
+}
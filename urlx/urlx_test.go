@@ -0,0 +1,29 @@
+package urlx
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+func atoi(s string) *result.Result[int] {
+	n, err := strconv.Atoi(s)
+	return result.New(&n, err)
+}
+
+func TestURL(t *testing.T) {
+	if Parse("http://[::1").IsOk() {
+		t.Error("Parse failed")
+	}
+	u := Parse("https://example.com/?page=2&q=&bad=x").Unwrap()
+	if *QueryGet(u, "q").Unwrap("") != "" || QueryGet(u, "missing").IsSome() {
+		t.Error("QueryGet failed")
+	}
+	if page := QueryGetParsed(u, "page", atoi).Unwrap(); *page.Unwrap("") != 2 {
+		t.Error("QueryGetParsed failed")
+	}
+	if !QueryGetParsed(u, "missing", atoi).Unwrap().IsNone() || QueryGetParsed(u, "bad", atoi).IsOk() {
+		t.Error("QueryGetParsed failed")
+	}
+}
@@ -0,0 +1,173 @@
+// Package validatex validates structs with go-playground/validator-style `validate` tags,
+// aware of [`Option`] fields: `required` means [`Some`], and the other rules apply
+// to the contained value of [`Some`] fields only.
+//
+// The rules are:
+//   - `required`: the field is not the zero value, or the option is a [`Some`];
+//   - `min=n`, `max=n`, `len=n`: bounds of numbers, or of the length of strings, slices and maps;
+//   - `oneof=a b c`: the field, formatted with `%v`, is one of the space-separated values;
+//   - `email`: the string is an email address.
+//
+// Nested structs are validated too, their fields named after the path from the validated struct.
+package validatex
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/internal/fields"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+// FieldError is the violation of a rule by a field.
+type FieldError struct {
+	// Field is the path of the field, e.g. `address.city`, its `json` tag names standing for its names.
+	Field string
+	// Rule is the violated rule, e.g. `min=1`.
+	Rule string
+}
+
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("%s: failed on %s", e.Field, e.Rule)
+}
+
+// ErrRule is the error of unknown or malformed rules.
+var ErrRule = errors.New("validatex: invalid rule")
+
+// Struct validates the struct, or the struct pointed to by `v`. The violations of all the fields
+// are joined as `*FieldError` values tagged with `errkind.Invalid`, with result.Details mapping
+// the fields to their violated rules.
+func Struct(v any) *result.Result[struct{}] {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return result.Err[struct{}](fmt.Errorf("validatex: %T is not a struct", v))
+	}
+	var violations []*FieldError
+	if err := validate(rv, "", &violations); err != nil {
+		return result.Err[struct{}](err)
+	}
+	if len(violations) == 0 {
+		return result.Ok(&struct{}{})
+	}
+	errs := make([]error, len(violations))
+	details := result.Details{Fields: make(map[string]string, len(violations))}
+	for i, v := range violations {
+		errs[i] = v
+		if _, ok := details.Fields[v.Field]; !ok {
+			details.Fields[v.Field] = v.Rule
+		}
+	}
+	return result.Err[struct{}](errkind.Wrap(errkind.Invalid, errors.Join(errs...))).WithDetails(details)
+}
+
+func validate(v reflect.Value, prefix string, violations *[]*FieldError) error {
+	t := v.Type()
+	for i := range t.NumField() {
+		name := fields.Name(t.Field(i), "json")
+		if name == "" {
+			continue
+		}
+		name = prefix + name
+		f := v.Field(i)
+		rules := splitRules(t.Field(i).Tag.Get("validate"))
+		if fields.IsOption(f.Type()) {
+			inner := fields.OptionValue(f)
+			if inner.IsNil() {
+				if slices.Contains(rules, "required") {
+					*violations = append(*violations, &FieldError{Field: name, Rule: "required"})
+				}
+				continue
+			}
+			f = inner.Elem()
+		} else if slices.Contains(rules, "required") && f.IsZero() {
+			*violations = append(*violations, &FieldError{Field: name, Rule: "required"})
+			continue
+		}
+		for _, rule := range rules {
+			if rule == "required" {
+				continue
+			}
+			ok, err := check(f, rule)
+			if err != nil {
+				return fmt.Errorf("%w %q on field %s: %w", ErrRule, rule, name, err)
+			}
+			if !ok {
+				*violations = append(*violations, &FieldError{Field: name, Rule: rule})
+			}
+		}
+		if s := reflect.Indirect(f); s.Kind() == reflect.Struct && !fields.IsOption(s.Type()) {
+			if err := validate(s, name+".", violations); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func splitRules(tag string) []string {
+	if tag == "" {
+		return nil
+	}
+	return strings.Split(tag, ",")
+}
+
+// check reports whether the value satisfies the rule.
+func check(v reflect.Value, rule string) (bool, error) {
+	name, param, _ := strings.Cut(rule, "=")
+	switch name {
+	case "min", "max", "len":
+		bound, err := strconv.ParseFloat(param, 64)
+		if err != nil {
+			return false, err
+		}
+		n, err := size(v)
+		if err != nil {
+			return false, err
+		}
+		switch name {
+		case "min":
+			return n >= bound, nil
+		case "max":
+			return n <= bound, nil
+		}
+		return n == bound, nil
+	case "oneof":
+		s := fmt.Sprint(v.Interface())
+		for _, option := range strings.Fields(param) {
+			if s == option {
+				return true, nil
+			}
+		}
+		return false, nil
+	case "email":
+		if v.Kind() != reflect.String {
+			return false, fmt.Errorf("email on %s", v.Type())
+		}
+		a, err := mail.ParseAddress(v.String())
+		return err == nil && a.Address == v.String(), nil
+	}
+	return false, errors.New("unknown rule")
+}
+
+// size returns the number or the length the bounds of a rule apply to.
+func size(v reflect.Value) (float64, error) {
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), nil
+	case reflect.String:
+		return float64(len([]rune(v.String()))), nil
+	case reflect.Slice, reflect.Array, reflect.Map:
+		return float64(v.Len()), nil
+	}
+	return 0, fmt.Errorf("bounds on %s", v.Type())
+}
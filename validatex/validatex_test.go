@@ -0,0 +1,58 @@
+package validatex
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/yuanzicheng/go-result-and-option/errkind"
+	"github.com/yuanzicheng/go-result-and-option/option"
+	"github.com/yuanzicheng/go-result-and-option/result"
+)
+
+type address struct {
+	City string `json:"city" validate:"required"`
+}
+
+type signup struct {
+	Name     string                 `json:"name" validate:"required,max=5"`
+	Email    option.Option[string]  `json:"email" validate:"required,email"`
+	Nickname option.Option[string]  `json:"nickname" validate:"min=3"`
+	Age      int                    `validate:"min=18"`
+	Plan     string                 `json:"plan" validate:"oneof=free pro"`
+	Address  option.Option[address] `json:"address"`
+}
+
+func TestStruct(t *testing.T) {
+	email, city := "bob@example.com", "Paris"
+	ok := signup{Name: "bob", Email: *option.Some(&email), Age: 18, Plan: "pro", Address: *option.Some(&address{City: city})}
+	if !Struct(&ok).IsOk() {
+		t.Errorf("Struct failed: %v", Struct(ok).UnwrapError())
+	}
+
+	bad, nick := "not an email", "b"
+	r := Struct(signup{Name: "robert", Email: *option.Some(&bad), Nickname: *option.Some(&nick), Plan: "gold", Address: *option.Some(&address{})})
+	err := r.UnwrapError()
+	if !errors.Is(err, errkind.Invalid) {
+		t.Error("Struct failed to tag the error")
+	}
+	d, _ := result.DetailsOf(r)
+	want := map[string]string{"name": "max=5", "email": "email", "nickname": "min=3", "Age": "min=18", "plan": "oneof=free pro", "address.city": "required"}
+	if !reflect.DeepEqual(d.Fields, want) {
+		t.Errorf("Struct failed: %v", d.Fields)
+	}
+
+	d, _ = result.DetailsOf(Struct(signup{Name: "bob", Age: 18, Plan: "free"}))
+	if !reflect.DeepEqual(d.Fields, map[string]string{"email": "required"}) {
+		t.Errorf("Struct failed on None: %v", d.Fields)
+	}
+}
+
+func TestInvalidRule(t *testing.T) {
+	type bad struct {
+		Name string `validate:"unknown"`
+	}
+	if err := Struct(bad{}).UnwrapError(); !errors.Is(err, ErrRule) {
+		t.Errorf("Struct failed to reject an unknown rule: %v", err)
+	}
+}